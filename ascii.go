@@ -0,0 +1,188 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// mod_spaced groups Mods that add words or apostrophes to the transformed
+// word. They are rejected by Generators restricted to ASCII words.
+const mod_spaced Mod = mod_determiner | mod_compound | MOD_POSSESSIVE
+
+// ASCII returns true if the Generator is restricted to single words
+// consisting solely of ASCII letters (see DefaultGeneratorASCII).
+func (gen *Generator) ASCII() bool {
+	return gen.ascii.Load()
+}
+
+// Sizes returns the lengths of all of the Generator's word lists, keyed
+// by WordClass. WC_PLACE, which has no word list, is omitted. For Generators restricted to ASCII words, these are
+// the numbers of the eligible words.
+func (gen *Generator) Sizes() map[WordClass]int {
+	lists := gen.lists.Load()
+
+	sizes := make(map[WordClass]int, len(lists))
+	for wc, list := range lists.listed() {
+		sizes[wc] = len(list)
+	}
+	return sizes
+}
+
+// DefaultGeneratorASCII returns a new Generator with default word lists,
+// restricted to single words consisting solely of ASCII letters, suitable
+// for identifiers and slugs. Every word that contains other characters,
+// either in its base form or in its irregular forms, is excluded from
+// the lists, e.g. "jack-o'-lantern" or "front", whose comparative
+// is "further front". Generator.Sizes reports the number of the eligible
+// words. The restriction affects the rest of the Generator's methods:
+//   - Generator.AddWord and Generator.ReplaceList reject such words
+//   - Generator.TransformWord rejects determiners, compound verb forms,
+//     possessive forms and the gradation of FT_REGULAR adjectives
+//     and adverbs, which yields "more" and "most" phrases
+//   - Generator.Adjective, Generator.Adverb and Generator.Phrase grade
+//     only suffixed and irregular words
+//   - Generator.Number and the number specifier of Generator.Phrase
+//     accept digits and single spelled words only - they return
+//     symbols.ErrSpacedMod for the spelled numbers consisting of several
+//     words (forty-two) and symbols.ErrNonASCII for the rest of non-letter
+//     characters, e.g. the sign of negative numbers
+//
+// src is the source of random numbers. If src is nil, a new, randomly seeded
+// rand.PCG is created.
+func DefaultGeneratorASCII(src *rand.Rand) (*Generator, error) {
+	gen, err := DefaultGenerator(src)
+	if err != nil {
+		return nil, err
+	}
+	if err := gen.restrictASCII(); err != nil {
+		return nil, err
+	}
+	return gen, nil
+}
+
+// NewGeneratorASCII works like NewGeneratorFromWord, but the returned
+// Generator is restricted to ASCII words, as described in
+// DefaultGeneratorASCII. Returns symbols.ErrEmptyLists if any of the lists
+// has no eligible word.
+func NewGeneratorASCII(adj, adv, noun, verb []Word, iterLimit int, safe bool, src *rand.Rand) (*Generator, error) {
+	gen, err := NewGeneratorFromWord(adj, adv, noun, verb, iterLimit, safe, src)
+	if err != nil {
+		return nil, err
+	}
+	if err := gen.restrictASCII(); err != nil {
+		return nil, err
+	}
+	return gen, nil
+}
+
+// restrictASCII removes the ineligible words from all of the Generator's
+// lists at once and restricts the Generator to ASCII words. Returns
+// symbols.ErrEmptyLists if any of the lists has no eligible word, in which
+// case the Generator is not modified.
+func (gen *Generator) restrictASCII() error {
+	gen.wmu.Lock()
+	defer gen.wmu.Unlock()
+
+	var lists wordLists
+
+	for wc, list := range gen.lists.Load().listed() {
+		eligible := make([]Word, 0, len(list))
+		for _, w := range list {
+			if w.isASCII() {
+				eligible = append(eligible, w)
+			}
+		}
+
+		if len(eligible) == 0 {
+			return fmt.Errorf("%s: %w", wc, symbols.ErrEmptyLists)
+		}
+		lists[wc] = eligible
+	}
+
+	gen.lists.Store(&lists)
+	gen.ascii.Store(true)
+
+	return nil
+}
+
+// checkASCII returns symbols.ErrNonASCII if the Generator is restricted
+// to ASCII words and w does not qualify.
+func (gen *Generator) checkASCII(w Word) error {
+	if gen.ascii.Load() && !w.isASCII() {
+		return symbols.ErrNonASCII
+	}
+	return nil
+}
+
+// checkASCIINumber returns an error if the Generator is restricted
+// to ASCII words and num, a formatted number, is neither a single word
+// of ASCII letters nor a string of digits. Spelled numbers consisting
+// of several words (forty-two) yield symbols.ErrSpacedMod, the rest
+// of the ineligible numbers (-5, naŭ) yield symbols.ErrNonASCII.
+func (gen *Generator) checkASCIINumber(num string) error {
+	if !gen.ascii.Load() {
+		return nil
+	}
+
+	if strings.ContainsAny(num, " -") && isASCIIWord(strings.NewReplacer(" ", "", "-", "").Replace(num)) {
+		return symbols.ErrSpacedMod
+	}
+
+	for i := range len(num) {
+		if c := num[i]; (c < '0' || c > '9') && !isASCIIWord(num[i:i+1]) {
+			return symbols.ErrNonASCII
+		}
+	}
+	return nil
+}
+
+// isASCII returns true if the word and its irregular forms consist solely
+// of ASCII letters.
+func (w Word) isASCII() bool {
+	if !isASCIIWord(w.word) {
+		return false
+	}
+
+	if w.irr != nil {
+		for _, f := range *w.irr {
+			if !isASCIIWord(f) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isASCIIWord returns true if s is a non-empty string of ASCII letters.
+func isASCIIWord(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for i := range len(s) {
+		if c := s[i] | 0x20; c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests isASCIIWord. Fails if a string containing characters other than
+// ASCII letters is accepted, or a valid one is rejected.
+func TestIsASCIIWord(t *testing.T) {
+	cases := map[string]bool{
+		"":                false,
+		"otter":           true,
+		"London":          true,
+		"look up":         false,
+		"mother-in-law":   false,
+		"jack-o'-lantern": false,
+		"4x4":             false,
+		"café":            false,
+		"a@b":             false,
+		"a[b":             false,
+	}
+
+	for input, expected := range cases {
+		if output := isASCIIWord(input); output != expected {
+			t.Errorf("Failed for '%s': expected %v, got %v", input, expected, output)
+		}
+	}
+}
+
+// Tests DefaultGeneratorASCII. Fails if an ineligible word remains
+// in the lists, is added later, or if a Mod adding words or apostrophes
+// is accepted.
+func TestDefaultGeneratorASCII(t *testing.T) {
+	gen, err := DefaultGeneratorASCII(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGeneratorASCII returned an error: %v", err)
+	}
+
+	if !gen.ASCII() {
+		t.Fatal("Failed: Generator is not restricted")
+	}
+
+	def, _ := DefaultGenerator(nil)
+	sizes, defSizes := gen.Sizes(), def.Sizes()
+
+	for wc, list := range gen.lists.Load() {
+		for _, w := range list {
+			if !w.isASCII() {
+				t.Errorf("Failed: ineligible word '%s' found in %s list", w.word, WordClass(wc))
+			}
+		}
+
+		if sizes[WordClass(wc)] != len(list) || sizes[WordClass(wc)] > defSizes[WordClass(wc)] {
+			t.Errorf("Failed: incorrect size of %s list: %d", WordClass(wc), sizes[WordClass(wc)])
+		}
+	}
+
+	if _, err := gen.Find("front", WC_ADJECTIVE); !errors.Is(err, symbols.ErrNotFound) {
+		t.Errorf("Failed: 'front', whose irregular forms contain spaces, remains in the list")
+	}
+
+	w, _ := NewWord("0look up")
+	if err := gen.AddWord(w, WC_VERB); !errors.Is(err, symbols.ErrNonASCII) {
+		t.Errorf("Failed for AddWord: expected ErrNonASCII, got %v", err)
+	}
+	if err := gen.ReplaceList([]Word{w}, WC_VERB); !errors.Is(err, symbols.ErrNonASCII) {
+		t.Errorf("Failed for ReplaceList: expected ErrNonASCII, got %v", err)
+	}
+	if _, err := gen.TransformWord(w, WC_VERB, MOD_GERUND); !errors.Is(err, symbols.ErrNonASCII) {
+		t.Errorf("Failed for TransformWord: expected ErrNonASCII, got %v", err)
+	}
+
+	spaced := []struct {
+		word string
+		wc   WordClass
+		mods Mod
+	}{
+		{"beautiful", WC_ADJECTIVE, MOD_COMPARATIVE},
+		{"otter", WC_NOUN, MOD_DEF},
+		{"otter", WC_NOUN, MOD_POSSESSIVE},
+		{"run", WC_VERB, MOD_FUTURE},
+		{"run", WC_VERB, MOD_NEGATIVE_CONTRACTED},
+	}
+
+	for _, c := range spaced {
+		if _, err := gen.Transform(c.word, c.wc, c.mods); !errors.Is(err, symbols.ErrSpacedMod) {
+			t.Errorf("Failed for '%s' (%s): expected ErrSpacedMod, got %v", c.word, c.mods, err)
+		}
+	}
+
+	numbers := []struct {
+		n        int
+		mods     Mod
+		expected string
+		err      error
+	}{
+		{7, MOD_SPELLED, "seven", nil},
+		{42, MOD_ORDINAL, "42nd", nil},
+		{42, MOD_SPELLED, "", symbols.ErrSpacedMod},
+		{100, MOD_SPELLED, "", symbols.ErrSpacedMod},
+		{-5, MOD_NONE, "", symbols.ErrNonASCII},
+	}
+
+	for _, c := range numbers {
+		if output, err := gen.Number(c.n, c.n, c.mods); !errors.Is(err, c.err) || output != c.expected {
+			t.Errorf("Failed for %d (%s): expected '%s' and %v, got '%s' and %v", c.n, c.mods, c.expected, c.err, output, err)
+		}
+	}
+
+	for range 1000 {
+		phrase, err := gen.Phrase("%ca%sa%pn%gv%2v%ta")
+		if err != nil {
+			t.Fatalf("Failed: Phrase returned an error: %v", err)
+		}
+		if !isASCIIWord(phrase) {
+			t.Fatalf("Failed: phrase '%s' contains characters other than ASCII letters", phrase)
+		}
+	}
+
+	if err := gen.SetForm("otter", WC_NOUN, MOD_PLURAL, "river otters"); err != nil {
+		t.Fatalf("Failed: SetForm returned an error: %v", err)
+	}
+	if _, err := gen.Transform("otter", WC_NOUN, MOD_PLURAL); !errors.Is(err, symbols.ErrNonASCII) {
+		t.Errorf("Failed for overridden form: expected ErrNonASCII, got %v", err)
+	}
+}
+
+// Tests NewGeneratorASCII. Fails if a Generator is returned although
+// one of the lists has no eligible word.
+func TestNewGeneratorASCII(t *testing.T) {
+	word := func(ln string) []Word {
+		w, _ := NewWord(ln)
+		return []Word{w}
+	}
+
+	gen, err := NewGeneratorASCII(word("3big"), word("0nicely"), word("0otter"), word("0look up"), DEFAULT_ITER_LIMIT, false, nil)
+	if !errors.Is(err, symbols.ErrEmptyLists) || gen != nil {
+		t.Errorf("Failed: expected ErrEmptyLists and nil Generator, got %v", err)
+	}
+
+	gen, err = NewGeneratorASCII(word("3big"), word("0nicely"), word("0otter"), word("0stash"), DEFAULT_ITER_LIMIT, false, nil)
+	if err != nil {
+		t.Fatalf("Failed: NewGeneratorASCII returned an error: %v", err)
+	}
+
+	if !gen.ASCII() || gen.Sizes()[WC_VERB] != 1 {
+		t.Errorf("Failed: Generator is not restricted or its lists are incomplete")
+	}
+}
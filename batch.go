@@ -0,0 +1,84 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import "github.com/Zedran/neng/symbols"
+
+// BatchOptions holds the settings of Generator.Batch.
+type BatchOptions struct {
+	// Minimum distance between every pair of generated phrases, counted
+	// in edits. 0 allows duplicates, 1 rejects them, as well as phrases
+	// differing by a single adjacent-key substitution under DIST_KEYBOARD.
+	MinDistance int
+
+	// Measure of the distance between phrases
+	Distance Distance
+}
+
+// Batch generates n phrases from pattern (see Generator.Phrase), ensuring
+// that every pair of them is at least opts.MinDistance apart, so that
+// one name is unlikely to be mistaken for another due to a typo.
+// The accepted phrases are indexed in a BK-tree, so that each candidate
+// is compared with a handful of them instead of the whole batch.
+//
+// Returns an error if:
+//   - n is not positive
+//   - opts.MinDistance is negative
+//   - opts.Distance is an undefined value
+//   - Generator.iterLimit consecutive candidates are rejected, e.g. when
+//     the pattern cannot produce n sufficiently distinct phrases
+//   - Generator.Phrase returns an error (relayed)
+func (gen *Generator) Batch(pattern string, n int, opts BatchOptions) ([]string, error) {
+	switch true {
+	case n <= 0:
+		return nil, symbols.ErrBadBatchSize
+	case opts.MinDistance < 0:
+		return nil, symbols.ErrBadDistance
+	case opts.Distance >= dist_undefined:
+		return nil, symbols.ErrUndefinedDistance
+	}
+
+	var (
+		batch = make([]string, 0, n)
+		tree  = newBKTree(opts.Distance.measure)
+
+		// Distances are measured in half-edits, a candidate closer than
+		// MinDistance edits to any accepted phrase is rejected
+		radius = 2*opts.MinDistance - 1
+	)
+
+	for rejected := 0; len(batch) < n; {
+		phrase, err := gen.Phrase(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		if radius >= 0 && tree.near(phrase, radius) {
+			if rejected++; rejected == gen.iterLimit {
+				return nil, symbols.ErrIterLimit
+			}
+			continue
+		}
+
+		tree.add(phrase)
+		batch = append(batch, phrase)
+		rejected = 0
+	}
+
+	return batch, nil
+}
@@ -0,0 +1,82 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests Generator.Batch. Fails if a pair of phrases is closer than
+// the requested minimum distance or an invalid argument is accepted.
+func TestGenerator_Batch(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	for _, opts := range []BatchOptions{{3, DIST_LEVENSHTEIN}, {3, DIST_KEYBOARD}} {
+		batch, err := gen.Batch("%a-%n", 300, opts)
+		if err != nil {
+			t.Fatalf("Failed for %v: Batch returned an error: %v", opts, err)
+		}
+
+		if len(batch) != 300 {
+			t.Fatalf("Failed for %v: expected 300 phrases, got %d", opts, len(batch))
+		}
+
+		for i := range batch {
+			for j := i + 1; j < len(batch); j++ {
+				if d := opts.Distance.measure([]rune(batch[i]), []rune(batch[j])); d < 2*opts.MinDistance {
+					t.Errorf("Failed for %v: '%s' and '%s' are too close (%d)", opts, batch[i], batch[j], d)
+				}
+			}
+		}
+	}
+
+	small, err := NewGenerator([]string{"3big"}, []string{"0nicely"}, []string{"0otter", "0outer"}, []string{"0stash"}, DEFAULT_ITER_LIMIT, false, nil)
+	if err != nil {
+		t.Fatalf("Failed: NewGenerator returned an error: %v", err)
+	}
+
+	errCases := []struct {
+		gen      *Generator
+		pattern  string
+		n        int
+		opts     BatchOptions
+		expected error
+	}{
+		{gen, "%n", 0, BatchOptions{}, symbols.ErrBadBatchSize},
+		{gen, "%n", 1, BatchOptions{MinDistance: -1}, symbols.ErrBadDistance},
+		{gen, "%n", 1, BatchOptions{Distance: dist_undefined}, symbols.ErrUndefinedDistance},
+		{gen, "%q", 1, BatchOptions{}, symbols.ErrUndefinedSpecifier},
+		{small, "%n", 2, BatchOptions{MinDistance: 2}, symbols.ErrIterLimit},
+	}
+
+	for _, c := range errCases {
+		if _, err := c.gen.Batch(c.pattern, c.n, c.opts); !errors.Is(err, c.expected) {
+			t.Errorf("Failed for '%s', %d, %v: expected '%v', got '%v'", c.pattern, c.n, c.opts, c.expected, err)
+		}
+	}
+
+	if batch, err := small.Batch("%n", 2, BatchOptions{MinDistance: 1}); err != nil || len(batch) != 2 {
+		t.Errorf("Failed for distinct phrases: got %v, error: %v", batch, err)
+	}
+}
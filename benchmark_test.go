@@ -41,8 +41,8 @@ func BenchmarkTransformAll_Adj(b *testing.B) {
 
 	gen, _ := DefaultGenerator(nil)
 
-	list := make([]string, len(gen.adj))
-	for i, w := range gen.adj {
+	list := make([]string, len(gen.lists.Load()[WC_ADJECTIVE]))
+	for i, w := range gen.lists.Load()[WC_ADJECTIVE] {
 		list[i] = w.word
 	}
 
@@ -78,8 +78,8 @@ func BenchmarkTransformAll_Adv(b *testing.B) {
 
 	gen, _ := DefaultGenerator(nil)
 
-	list := make([]string, len(gen.adv))
-	for i, w := range gen.adv {
+	list := make([]string, len(gen.lists.Load()[WC_ADVERB]))
+	for i, w := range gen.lists.Load()[WC_ADVERB] {
 		list[i] = w.word
 	}
 
@@ -115,8 +115,8 @@ func BenchmarkTransformAll_Noun(b *testing.B) {
 
 	gen, _ := DefaultGenerator(nil)
 
-	list := make([]string, len(gen.noun))
-	for i, w := range gen.noun {
+	list := make([]string, len(gen.lists.Load()[WC_NOUN]))
+	for i, w := range gen.lists.Load()[WC_NOUN] {
 		list[i] = w.word
 	}
 
@@ -179,8 +179,8 @@ func BenchmarkTransformAll_Verb(b *testing.B) {
 
 	gen, _ := DefaultGenerator(nil)
 
-	list := make([]string, len(gen.verb))
-	for i, w := range gen.verb {
+	list := make([]string, len(gen.lists.Load()[WC_VERB]))
+	for i, w := range gen.lists.Load()[WC_VERB] {
 		list[i] = w.word
 	}
 
@@ -263,3 +263,14 @@ func BenchmarkTransformOne_Verb(b *testing.B) {
 		gen.TransformWord(f, wc, MOD_GERUND)
 	}
 }
+
+func BenchmarkGenerator_Batch(b *testing.B) {
+	b.StopTimer()
+
+	gen, _ := DefaultGenerator(nil)
+
+	b.StartTimer()
+	for range b.N {
+		gen.Batch("%a-%n", 2000, BatchOptions{MinDistance: 3})
+	}
+}
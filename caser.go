@@ -25,6 +25,9 @@ import (
 	"golang.org/x/text/language"
 )
 
+// mod_case groups case transformation Mods.
+const mod_case Mod = MOD_CASE_LOWER | MOD_CASE_SENTENCE | MOD_CASE_TITLE | MOD_CASE_UPPER
+
 // caser handles case transformations.
 type caser struct {
 	lower cases.Caser
@@ -33,26 +36,69 @@ type caser struct {
 	mu    sync.Mutex
 }
 
+// apply transforms word according to the case transformation Mod
+// enabled in mods. If multiple case transformations are enabled,
+// the one with the lowest value is applied.
+func (c *caser) apply(word string, mods Mod) string {
+	switch true {
+	case mods.Enabled(MOD_CASE_LOWER):
+		return c.toLower(word)
+	case mods.Enabled(MOD_CASE_SENTENCE):
+		return c.toSentence(word)
+	case mods.Enabled(MOD_CASE_TITLE):
+		return c.toTitle(word)
+	case mods.Enabled(MOD_CASE_UPPER):
+		return c.toUpper(word)
+	}
+	return word
+}
+
 // toLower transforms word to lower case.
 func (c *caser) toLower(word string) string {
 	return c.lower.String(word)
 }
 
-// toSentence transforms the first word in a space-separated sequence
-// to title case and everything that follows to lower case.
+// minorWords lists articles, conjunctions and short prepositions that
+// remain lower case inside hyphenated compounds in title case.
+var minorWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "at": {}, "by": {}, "for": {}, "in": {},
+	"of": {}, "on": {}, "or": {}, "the": {}, "to": {}, "with": {},
+}
+
+// toSentence transforms the first element of a sequence separated
+// by spaces or hyphens to title case and everything that follows
+// to lower case (Mother-in-law).
 func (c *caser) toSentence(words string) string {
-	space := strings.Index(words, " ")
-	if space == -1 {
+	sep := strings.IndexAny(words, " -")
+	if sep == -1 {
 		return c.toTitle(words)
 	}
-	return c.toTitle(words[:space]) + c.toLower(words[space:])
+	return c.toTitle(words[:sep]) + c.toLower(words[sep:])
 }
 
-// toTitle transforms word to title case.
+// toTitle transforms word to title case. Minor words between the first
+// and the last element of a hyphenated compound are transformed
+// to lower case (Mother-in-Law, Jack-in-the-Box).
 func (c *caser) toTitle(word string) string {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-	return c.title.String(word)
+	title := c.title.String(word)
+	c.mu.Unlock()
+
+	if strings.Count(title, "-") < 2 {
+		return title
+	}
+
+	tokens := strings.Split(title, " ")
+	for i, t := range tokens {
+		elems := strings.Split(t, "-")
+		for j := 1; j < len(elems)-1; j++ {
+			if _, ok := minorWords[strings.ToLower(elems[j])]; ok {
+				elems[j] = c.toLower(elems[j])
+			}
+		}
+		tokens[i] = strings.Join(elems, "-")
+	}
+	return strings.Join(tokens, " ")
 }
 
 // toUpper transforms word to upper case.
@@ -60,11 +106,11 @@ func (c *caser) toUpper(word string) string {
 	return c.upper.String(word)
 }
 
-// newCaser returns a pointer to new caser struct.
-func newCaser() caser {
+// newCaser returns a new caser struct following the casing rules of tag.
+func newCaser(tag language.Tag) caser {
 	return caser{
-		lower: cases.Lower(language.English),
-		title: cases.Title(language.English),
-		upper: cases.Upper(language.English),
+		lower: cases.Lower(tag),
+		title: cases.Title(tag),
+		upper: cases.Upper(tag),
 	}
 }
@@ -17,7 +17,11 @@
 
 package neng
 
-import "testing"
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
 
 // Tests case transformations handled by caser.
 func TestCaser(t *testing.T) {
@@ -27,7 +31,7 @@ func TestCaser(t *testing.T) {
 		function func(string) string
 	}
 
-	caser := newCaser()
+	caser := newCaser(language.English)
 
 	cases := []testCase{
 		{"LOWER", "lower", caser.toLower},
@@ -38,6 +42,12 @@ func TestCaser(t *testing.T) {
 		{"title", "Title", caser.toTitle},
 		{"tItLe", "Title", caser.toTitle},
 		{"a man", "A Man", caser.toTitle},
+		{"mother-in-law", "Mother-in-law", caser.toSentence},
+		{"STIR-FRY", "Stir-fry", caser.toSentence},
+		{"mother-in-law", "Mother-in-Law", caser.toTitle},
+		{"a jack-in-the-box", "A Jack-in-the-Box", caser.toTitle},
+		{"passer-by", "Passer-By", caser.toTitle},
+		{"forget-me-not", "Forget-Me-Not", caser.toTitle},
 		{"upper", "UPPER", caser.toUpper},
 		{"uPpEr", "UPPER", caser.toUpper},
 	}
@@ -0,0 +1,58 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+// mod_determiner groups Mods that insert a determiner before a word.
+const mod_determiner Mod = MOD_INDEF | MOD_DEF | MOD_EVERY | MOD_SOME | MOD_MANY | MOD_MUCH
+
+// determiner inserts the determiner requested in mods before w.
+// If no determiner is requested, w is returned unchanged.
+func determiner(w string, mods Mod) string {
+	switch true {
+	case mods.Enabled(MOD_INDEF):
+		return indefinite(w)
+	case mods.Enabled(MOD_DEF):
+		return "the " + w
+	case mods.Enabled(MOD_EVERY):
+		return "every " + w
+	case mods.Enabled(MOD_SOME):
+		return "some " + w
+	case mods.Enabled(MOD_MANY):
+		return "many " + w
+	case mods.Enabled(MOD_MUCH):
+		return "much " + w
+	}
+	return w
+}
+
+// nounExclusions returns FormType values of nouns that cannot be
+// transformed according to mods.
+func nounExclusions(mods Mod) []FormType {
+	switch true {
+	case mods.Enabled(MOD_PLURAL | MOD_MANY):
+		return []FormType{FT_UNCOUNTABLE}
+	case mods.Enabled(MOD_INDEF | MOD_INDEF_SILENT | MOD_EVERY):
+		return []FormType{FT_PLURAL_ONLY, FT_UNCOUNTABLE}
+	case mods.Enabled(MOD_MUCH):
+		return []FormType{FT_REGULAR, FT_IRREGULAR, FT_PLURAL_ONLY}
+	case mods.Enabled(MOD_SOME):
+		return []FormType{FT_REGULAR, FT_IRREGULAR}
+	default:
+		return []FormType{FT_PLURAL_ONLY}
+	}
+}
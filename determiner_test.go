@@ -0,0 +1,114 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Zedran/neng/internal/tests"
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests whether determiners are inserted correctly and whether nouns
+// incompatible with them are rejected.
+func TestDeterminer(t *testing.T) {
+	type testCase struct {
+		Input    string    `json:"input"`
+		WC       WordClass `json:"word_class"`
+		Mods     Mod       `json:"mods"`
+		Expected string    `json:"expected"`
+	}
+
+	var cases []testCase
+	if err := tests.ReadData("TestDeterminer.json", &cases); err != nil {
+		t.Fatalf("Failed loading test data: %v", err)
+	}
+
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	for _, c := range cases {
+		output, err := gen.Transform(c.Input, c.WC, c.Mods)
+		if err != nil {
+			t.Errorf("Failed for '%s' (%s): error returned: %v", c.Input, c.Mods, err)
+			continue
+		}
+
+		if output != c.Expected {
+			t.Errorf("Failed for '%s' (%s): expected '%s', got '%s'", c.Input, c.Mods, c.Expected, output)
+		}
+	}
+
+	errCases := []struct {
+		input    string
+		mods     Mod
+		expected error
+	}{
+		{"abrasiveness", MOD_EVERY, symbols.ErrUncountable},
+		{"abrasiveness", MOD_MANY, symbols.ErrUncountable},
+		{"trousers", MOD_EVERY, symbols.ErrPluralOnly},
+		{"trousers", MOD_MUCH, symbols.ErrCountable},
+		{"box", MOD_MUCH, symbols.ErrCountable},
+		{"box", MOD_SOME, symbols.ErrCountable},
+		{"box", MOD_EVERY | MOD_PLURAL, symbols.ErrIncompatible},
+		{"box", MOD_DEF | MOD_INDEF, symbols.ErrIncompatible},
+	}
+
+	for _, c := range errCases {
+		if output, err := gen.Transform(c.input, WC_NOUN, c.mods); !errors.Is(err, c.expected) {
+			t.Errorf("Failed for '%s' (%s): expected %v, got '%s', %v", c.input, c.mods, c.expected, output, err)
+		}
+	}
+}
+
+// Tests whether nounExclusions is consistent with Generator.TransformWord,
+// i.e. whether Generator.Noun never draws a noun that cannot be transformed
+// according to the requested determiner and always accepts the ones
+// that can.
+func TestNounExclusions(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	nouns := gen.lists.Load()[WC_NOUN]
+
+	for _, m := range []Mod{
+		MOD_NONE, MOD_PLURAL, MOD_INDEF, MOD_INDEF_SILENT, MOD_DEF, MOD_EVERY,
+		MOD_SOME, MOD_SOME | MOD_PLURAL, MOD_MANY, MOD_MUCH, MOD_MUCH | MOD_DET_SILENT,
+	} {
+		excluded := nounExclusions(m)
+
+		// Plural-only nouns are excluded by default, even though
+		// they can be transformed
+		lenient := slices.Equal(excluded, []FormType{FT_PLURAL_ONLY})
+
+		for _, n := range nouns {
+			_, err := gen.TransformWord(n, WC_NOUN, m)
+
+			if ex := slices.Contains(excluded, n.ft); ex != (err != nil) && !(ex && lenient) {
+				t.Errorf("Failed for '%s' (%s): excluded: %v, error: %v", n.word, m, ex, err)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,208 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import "strings"
+
+// Distance selects the measure of similarity between two phrases.
+type Distance uint8
+
+const (
+	// Levenshtein distance - the number of single-letter insertions,
+	// deletions and substitutions needed to turn one phrase into another.
+	DIST_LEVENSHTEIN Distance = iota
+
+	// Levenshtein distance in which substituting a letter with one
+	// of its neighbours on the QWERTY keyboard counts as half an edit,
+	// because such typos are the most common ('brave', 'grave').
+	DIST_KEYBOARD
+
+	// Internal value, declared to mark the end of usable Distance values.
+	dist_undefined
+)
+
+// qwertyRows lists the letter rows of the QWERTY keyboard, top to bottom.
+var qwertyRows = [3]string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}
+
+// measure returns the distance between a and b in half-edits, so that
+// the values of both metrics share the unit.
+func (d Distance) measure(a, b []rune) int {
+	return editDistance(a, b, 2, d == DIST_KEYBOARD)
+}
+
+// adjacentKeys returns true if x and y are neighbouring letters
+// on the QWERTY keyboard.
+func adjacentKeys(x, y rune) bool {
+	rx, cx := keyPosition(x)
+	ry, cy := keyPosition(y)
+
+	if rx == -1 || ry == -1 {
+		return false
+	}
+
+	// The rows are staggered: the key at column c touches columns c and c+1
+	// of the row above it.
+	switch ry - rx {
+	case 0:
+		return cy-cx == 1 || cx-cy == 1
+	case -1:
+		return cy == cx || cy == cx+1
+	case 1:
+		return cx == cy || cx == cy+1
+	}
+	return false
+}
+
+// keyPosition returns the row and column of letter r on the QWERTY
+// keyboard, or -1, -1 if r is not a lower case letter.
+func keyPosition(r rune) (int, int) {
+	for row, keys := range qwertyRows {
+		if col := strings.IndexRune(keys, r); col != -1 {
+			return row, col
+		}
+	}
+	return -1, -1
+}
+
+// editDistance returns the weighted edit distance between a and b.
+// indel is the cost of an insertion, deletion and substitution. If keyboard
+// is true, substituting a letter with its neighbour on the keyboard costs
+// half of indel.
+func editDistance(a, b []rune, indel int, keyboard bool) int {
+	var buf [2 * 65]int
+
+	row := buf[:0]
+	if 2*(len(b)+1) > len(buf) {
+		row = make([]int, 0, 2*(len(b)+1))
+	}
+	row = row[:2*(len(b)+1)]
+
+	prev, curr := row[:len(b)+1], row[len(b)+1:]
+
+	for j := range prev {
+		prev[j] = j * indel
+	}
+
+	for i, ca := range a {
+		curr[0] = (i + 1) * indel
+		left, diag := curr[0], prev[0]
+
+		for j, cb := range b {
+			up := prev[j+1]
+
+			cost := 0
+			if ca != cb {
+				cost = indel
+				if keyboard && adjacentKeys(ca, cb) {
+					cost = indel / 2
+				}
+			}
+
+			left = min(up+indel, left+indel, diag+cost)
+			curr[j+1], diag = left, up
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+// bkTree is a Burkhard-Keller tree, which indexes strings by a metric
+// and finds the ones within a given distance of a query without
+// comparing it to every element. The strings are compared in lower case.
+type bkTree struct {
+	root   *bkNode
+	metric func(a, b []rune) int
+}
+
+// bkNode is a single element of bkTree. The children are keyed
+// by their distance from the node.
+type bkNode struct {
+	word     string
+	runes    []rune
+	children map[int]*bkNode
+}
+
+// newBKTree returns an empty bkTree that uses metric.
+func newBKTree(metric func(a, b []rune) int) *bkTree {
+	return &bkTree{metric: metric}
+}
+
+// add inserts s into the tree.
+func (t *bkTree) add(s string) {
+	node := &bkNode{word: s, runes: []rune(strings.ToLower(s))}
+
+	if t.root == nil {
+		t.root = node
+		return
+	}
+
+	for n := t.root; ; {
+		d := t.metric(node.runes, n.runes)
+
+		child, ok := n.children[d]
+		if !ok {
+			if n.children == nil {
+				n.children = make(map[int]*bkNode)
+			}
+			n.children[d] = node
+			return
+		}
+		n = child
+	}
+}
+
+// within calls yield for every element of the tree whose distance from s
+// does not exceed radius, until yield returns false.
+func (t *bkTree) within(s string, radius int, yield func(word string, dist int) bool) {
+	if t.root == nil {
+		return
+	}
+
+	query := []rune(strings.ToLower(s))
+	stack := []*bkNode{t.root}
+
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		d := t.metric(query, n.runes)
+		if d <= radius && !yield(n.word, d) {
+			return
+		}
+
+		// By the triangle inequality, only the children at distance
+		// [d-radius, d+radius] from the node can be within radius of s
+		for cd, child := range n.children {
+			if cd >= d-radius && cd <= d+radius {
+				stack = append(stack, child)
+			}
+		}
+	}
+}
+
+// near returns true if any element of the tree lies within radius of s.
+func (t *bkTree) near(s string, radius int) bool {
+	found := false
+	t.within(s, radius, func(string, int) bool {
+		found = true
+		return false
+	})
+	return found
+}
@@ -0,0 +1,119 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// Tests Distance.measure. Fails if a distance between two words
+// is incorrect.
+func TestDistance_measure(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		d        Distance
+		expected int
+	}{
+		{"kitten", "sitting", DIST_LEVENSHTEIN, 6},
+		{"brave-otter", "grave-otter", DIST_LEVENSHTEIN, 2},
+		{"brave-otter", "grave-otter", DIST_KEYBOARD, 1},
+		{"brave-otter", "crave-otter", DIST_KEYBOARD, 2},
+		{"Otter", "otter", DIST_LEVENSHTEIN, 0},
+		{"", "otter", DIST_KEYBOARD, 10},
+		{"ąb", "ab", DIST_LEVENSHTEIN, 2},
+	}
+
+	for _, c := range cases {
+		if output := c.d.measure([]rune(strings.ToLower(c.a)), []rune(strings.ToLower(c.b))); output != c.expected {
+			t.Errorf("Failed for '%s', '%s' (%d): expected %d, got %d", c.a, c.b, c.d, c.expected, output)
+		}
+	}
+}
+
+// Tests adjacentKeys. Fails if keys are incorrectly classified
+// as neighbours.
+func TestAdjacentKeys(t *testing.T) {
+	cases := []struct {
+		x, y     rune
+		expected bool
+	}{
+		{'g', 'b', true},
+		{'g', 'h', true},
+		{'g', 't', true},
+		{'g', 'y', true},
+		{'g', 'v', true},
+		{'g', 'r', false},
+		{'g', 'n', false},
+		{'s', 'z', true},
+		{'a', 'p', false},
+		{'a', '1', false},
+	}
+
+	for _, c := range cases {
+		if output := adjacentKeys(c.x, c.y); output != c.expected {
+			t.Errorf("Failed for '%c', '%c': expected %t, got %t", c.x, c.y, c.expected, output)
+		}
+		if output := adjacentKeys(c.y, c.x); output != c.expected {
+			t.Errorf("Failed for '%c', '%c': expected %t, got %t", c.y, c.x, c.expected, output)
+		}
+	}
+}
+
+// Tests bkTree.within. Fails if the result differs from comparing
+// the query with every element of the tree.
+func TestBKTree_within(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	var words []string
+	for _, w := range gen.lists.Load()[WC_NOUN][:2000] {
+		words = append(words, w.word)
+	}
+
+	tree := newBKTree(DIST_LEVENSHTEIN.measure)
+	for _, w := range words {
+		tree.add(w)
+	}
+
+	for _, query := range []string{"abbey", "acorn", "zzz"} {
+		for _, radius := range []int{0, 2, 4} {
+			var expected, output []string
+
+			for _, w := range words {
+				if DIST_LEVENSHTEIN.measure([]rune(query), []rune(w)) <= radius {
+					expected = append(expected, w)
+				}
+			}
+
+			tree.within(query, radius, func(w string, _ int) bool {
+				output = append(output, w)
+				return true
+			})
+
+			slices.Sort(output)
+
+			if !slices.Equal(expected, output) {
+				t.Errorf("Failed for '%s' (%d): expected %v, got %v", query, radius, expected, output)
+			}
+		}
+	}
+}
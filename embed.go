@@ -19,24 +19,119 @@ package neng
 
 import (
 	"embed"
+	"fmt"
+	"path"
+	"strconv"
 	"strings"
+	"sync"
+
+	"github.com/Zedran/neng/symbols"
 )
 
 //go:embed embed/*
 var efs embed.FS
 
-// readEFS loads a word list from the embedded path.
-// Returns an error if the file is not found.
-func readEFS(path string) ([]string, error) {
-	stream, err := efs.ReadFile(path)
-	if err != nil {
-		return nil, err
+// extraWordLists holds the word lists from WC_PRONOUN to WC_SURNAME.
+type extraWordLists [WC_PLACE - WC_PRONOUN][]Word
+
+// extraLists maps the base language codes of the embedded language packs
+// to functions returning their lists of closed word classes and proper
+// names. The lists are read once and shared by all Generators, which never
+// modify them in place.
+var extraLists = func() map[string]func() (extraWordLists, error) {
+	m := make(map[string]func() (extraWordLists, error), len(embeddedDirs))
+
+	for code, dir := range embeddedDirs {
+		m[code] = sync.OnceValues(func() (extraWordLists, error) {
+			return readExtraLists(dir)
+		})
 	}
 
-	lines := strings.Split(string(stream), "\n")
+	return m
+}()
+
+// readExtraLists reads the embedded lists of closed word classes stored
+// in dir. Proper names are shared by all languages and are always read
+// from the English directory.
+func readExtraLists(dir string) (extraWordLists, error) {
+	var lists extraWordLists
+
+	for i, name := range []string{"pron", "prep", "conj", "intj", "given", "surname"} {
+		p := path.Join(dir, name)
+		if i >= int(WC_GIVEN_NAME-WC_PRONOUN) {
+			p = path.Join(embeddedDirs["en"], name)
+		}
 
-	if len(lines[len(lines)-1]) == 0 {
-		return lines[:len(lines)-1], nil
+		words, err := readWordFile(efs, p)
+		if err != nil {
+			return lists, err
+		}
+		lists[i] = words
 	}
-	return lines, nil
+
+	return lists, nil
 }
+
+// placeMorphemes returns the embedded stems and suffixes of place names.
+// They are read once and shared by all Generators.
+var placeMorphemes = sync.OnceValues(func() (*morphemes, error) {
+	var m morphemes
+
+	for _, t := range []struct {
+		name string
+		dst  *[]string
+	}{{"place", &m.stems}, {"place.suf", &m.suffixes}} {
+		words, err := readWordFile(efs, path.Join(embeddedDirs["en"], t.name))
+		if err != nil {
+			return nil, err
+		}
+
+		*t.dst = make([]string, len(words))
+		for i, w := range words {
+			(*t.dst)[i] = w.word
+		}
+	}
+
+	return &m, nil
+})
+
+// embeddedScores returns the pronounceability scores of the words
+// from the embedded language packs, precomputed by the embed script.
+// They are read once and shared by all Generators.
+var embeddedScores = sync.OnceValues(func() (scoreIndex, error) {
+	index := make(scoreIndex)
+
+	for _, dir := range embeddedDirs {
+		p := path.Join(dir, "score")
+
+		f, err := efs.Open(p)
+		if err != nil {
+			return nil, err
+		}
+
+		err = scanLines(f, func(line string) error {
+			score, word, ok := strings.Cut(line, ",")
+			if !ok {
+				return symbols.ErrBadWordList
+			}
+
+			n, err := strconv.ParseUint(score, 10, 8)
+			if err != nil {
+				return err
+			}
+			if n > 100 {
+				return symbols.ErrBadScore
+			}
+
+			index[word] = uint8(n)
+			return nil
+		})
+		f.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+	}
+
+	return index, nil
+})
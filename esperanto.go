@@ -0,0 +1,289 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Esperanto is a Language with regular morphology, which demonstrates that
+// the Generator is not bound to English. Nouns end with '-o' and take '-j'
+// in plural, adjectives end with '-a', adverbs with '-e' and verbs with
+// '-i'. The language pack of Esperanto is embedded and can be loaded
+// with DefaultGeneratorForLanguage.
+//
+// English Mods are mapped to their closest Esperanto counterparts:
+//   - MOD_COMPARATIVE, MOD_SUPERLATIVE - pli, plej (pli bela)
+//   - MOD_POSSESSIVE                   - de (de hundo)
+//   - MOD_PAST_SIMPLE, MOD_PRESENT_SIMPLE, MOD_FUTURE, MOD_INFINITIVE
+//   - -is, -as, -os, -i (kuris)
+//   - MOD_PERFECT, MOD_PROGRESSIVE, MOD_PASSIVE - esti followed by
+//     a participle (estas kurinta, estas kuranta, estas manĝata)
+//   - MOD_PAST_PARTICIPLE, MOD_GERUND - -ita, -ante (manĝita, kurante)
+//   - MOD_NEGATIVE, MOD_NEGATIVE_CONTRACTED - ne (ne kuras)
+//   - MOD_OBJECTIVE - accusative -n (lin)
+//
+// Esperanto has no indefinite article, so MOD_INDEF leaves the word
+// unchanged.
+type Esperanto struct{}
+
+// Determine implements Language. Determiners are inserted after the
+// preposition 'de' of possessive nouns (de la hundo).
+func (eo Esperanto) Determine(w string, mods Mod) string {
+	if rest, found := strings.CutPrefix(w, "de "); found && mods.Enabled(mod_determiner&^MOD_INDEF) {
+		return "de " + eo.Determine(rest, mods)
+	}
+
+	switch true {
+	case mods.Enabled(MOD_DEF):
+		return "la " + w
+	case mods.Enabled(MOD_EVERY):
+		return "ĉiu " + w
+	case mods.Enabled(MOD_SOME):
+		if mods.Enabled(MOD_PLURAL) {
+			return "kelkaj " + w
+		}
+		return "iom da " + w
+	case mods.Enabled(MOD_MANY):
+		return "multaj " + w
+	case mods.Enabled(MOD_MUCH):
+		return "multe da " + w
+	}
+	return w
+}
+
+// Inflect implements Language. Adjectives described with MOD_MANY
+// agree with the plural noun (multaj belaj), as do the adjectives
+// receiving MOD_PLURAL from Generator.Phrase (3 belaj hundoj). Of the
+// forms set with Generator.SetForm, only the plurals of nouns, the
+// objective pronouns and the simple verb forms are used, the rest
+// of opts is ignored.
+func (Esperanto) Inflect(word Word, wc WordClass, mods Mod, opts InflectOptions) string {
+	w := word.word
+
+	switch wc {
+	case WC_ADJECTIVE, WC_ADVERB:
+		if mods.Enabled(MOD_PLURAL | MOD_MANY) {
+			w += "j"
+		}
+		if mods.Enabled(MOD_COMPARATIVE) {
+			return "pli " + w
+		} else if mods.Enabled(MOD_SUPERLATIVE) {
+			return "plej " + w
+		}
+	case WC_NOUN, WC_GIVEN_NAME, WC_SURNAME, WC_PLACE:
+		if mods.Enabled(MOD_PLURAL | MOD_MANY) {
+			if form, ok := opts.override(MOD_PLURAL); ok {
+				w = form
+			} else {
+				w += "j"
+			}
+		}
+		if mods.Enabled(MOD_POSSESSIVE) {
+			return "de " + w
+		}
+	case WC_VERB:
+		simple := mods & (MOD_PAST_SIMPLE | MOD_PAST_PARTICIPLE | MOD_PRESENT_SIMPLE | MOD_GERUND | MOD_PLURAL)
+		if simple != MOD_NONE && !mods.Enabled(mod_compound|MOD_NEGATIVE|MOD_NEGATIVE_CONTRACTED) {
+			if form, ok := opts.override(simple); ok {
+				return form
+			}
+		}
+		return eoVerb(w, mods)
+	case WC_PRONOUN:
+		if mods.Enabled(MOD_OBJECTIVE) {
+			if form, ok := opts.override(MOD_OBJECTIVE); ok {
+				return form
+			}
+			return w + "n"
+		}
+	}
+
+	return w
+}
+
+// Number implements Language. Ordinals take the '-a' ending (42-a, dua).
+func (Esperanto) Number(n int, mods Mod) string {
+	if !mods.Enabled(MOD_SPELLED) {
+		s := strconv.Itoa(n)
+		if mods.Enabled(MOD_ORDINAL) {
+			s += "-a"
+		}
+		return s
+	}
+
+	s := eoSpellNumber(n)
+	if mods.Enabled(MOD_ORDINAL) {
+		// Multi-word ordinals are hyphenated (kvardek-dua)
+		s = strings.ReplaceAll(s, " ", "-") + "a"
+	}
+	return s
+}
+
+// Tag implements Language.
+func (Esperanto) Tag() language.Tag {
+	return language.MustParse("eo")
+}
+
+// eoDigits holds Esperanto names of digits, indexed by their values.
+var eoDigits = [...]string{"nul", "unu", "du", "tri", "kvar", "kvin", "ses", "sep", "ok", "naŭ"}
+
+// eoScales holds the names of large numbers in descending order.
+var eoScales = []struct {
+	value uint64
+	name  string
+}{
+	{1_000_000_000_000_000_000, "triliono"},
+	{1_000_000_000_000_000, "biliardo"},
+	{1_000_000_000_000, "biliono"},
+	{1_000_000_000, "miliardo"},
+	{1_000_000, "miliono"},
+}
+
+// eoSpellNumber writes n in Esperanto words (kvardek du).
+func eoSpellNumber(n int) string {
+	if n == 0 {
+		return eoDigits[0]
+	}
+
+	var parts []string
+
+	if n < 0 {
+		parts = append(parts, "minus")
+	}
+
+	// Conversion to uint64 before negation handles math.MinInt
+	u := uint64(n)
+	if n < 0 {
+		u = -u
+	}
+
+	for _, sc := range eoScales {
+		if q := u / sc.value; q > 0 {
+			name := sc.name
+			if q > 1 {
+				parts = append(parts, eoSpellHundreds(q)...)
+				name += "j"
+			}
+			parts = append(parts, name)
+			u %= sc.value
+		}
+	}
+
+	if q := u / 1000; q > 0 {
+		if q > 1 {
+			parts = append(parts, eoSpellHundreds(q)...)
+		}
+		parts = append(parts, "mil")
+		u %= 1000
+	}
+
+	parts = append(parts, eoSpellHundreds(u)...)
+
+	return strings.Join(parts, " ")
+}
+
+// eoSpellHundreds returns the words of n in range [0, 999]. Multiples
+// of ten and hundred are written as single words (dudek, tricent).
+func eoSpellHundreds(n uint64) []string {
+	var parts []string
+
+	if h := n / 100; h > 0 {
+		parts = append(parts, eoPrefix(h)+"cent")
+	}
+
+	if t := n / 10 % 10; t > 0 {
+		parts = append(parts, eoPrefix(t)+"dek")
+	}
+
+	if u := n % 10; u > 0 {
+		parts = append(parts, eoDigits[u])
+	}
+
+	return parts
+}
+
+// eoPrefix returns the multiplier of 'dek' and 'cent', which is omitted
+// for 1 (dek, dudek).
+func eoPrefix(d uint64) string {
+	if d == 1 {
+		return ""
+	}
+	return eoDigits[d]
+}
+
+// eoVerb conjugates an Esperanto verb. The first verb of the chain
+// takes the ending of the tense, the main verb of a compound form
+// becomes a participle, which agrees with plural subjects.
+func eoVerb(verb string, mods Mod) string {
+	root := verb[:len(verb)-1]
+
+	var tense string
+
+	switch true {
+	case mods.Enabled(MOD_FUTURE):
+		tense = "os"
+	case mods.Enabled(MOD_INFINITIVE):
+		tense = "i"
+	case mods.Enabled(MOD_PAST_SIMPLE):
+		tense = "is"
+	case mods.Enabled(MOD_PAST_PARTICIPLE):
+		return root + "ita"
+	case mods.Enabled(MOD_GERUND):
+		return root + "ante"
+	default:
+		tense = "as"
+	}
+
+	if !mods.Enabled(mod_compound) && !mods.Enabled(MOD_PAST_SIMPLE|MOD_PRESENT_SIMPLE) {
+		return verb
+	}
+
+	var participle string
+
+	switch true {
+	case mods.Enabled(MOD_PASSIVE) && mods.Enabled(MOD_PERFECT):
+		participle = "ita"
+	case mods.Enabled(MOD_PASSIVE):
+		participle = "ata"
+	case mods.Enabled(MOD_PERFECT):
+		participle = "inta"
+	case mods.Enabled(MOD_PROGRESSIVE):
+		participle = "anta"
+	}
+
+	var parts []string
+
+	if mods.Enabled(MOD_NEGATIVE | MOD_NEGATIVE_CONTRACTED) {
+		parts = append(parts, "ne")
+	}
+
+	if len(participle) > 0 {
+		if mods.Enabled(MOD_PLURAL) {
+			participle += "j"
+		}
+		parts = append(parts, "est"+tense, root+participle)
+	} else {
+		parts = append(parts, root+tense)
+	}
+
+	return strings.Join(parts, " ")
+}
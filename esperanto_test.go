@@ -0,0 +1,116 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"testing"
+)
+
+// Tests Esperanto.Inflect. Fails if an incorrect form is returned.
+func TestEsperanto_Inflect(t *testing.T) {
+	cases := []struct {
+		word     string
+		wc       WordClass
+		mods     Mod
+		expected string
+	}{
+		{"bela", WC_ADJECTIVE, MOD_COMPARATIVE, "pli bela"},
+		{"bela", WC_ADJECTIVE, MOD_MANY, "belaj"},
+		{"bela", WC_ADJECTIVE, MOD_PLURAL | MOD_COMPARATIVE, "pli belaj"},
+		{"rapide", WC_ADVERB, MOD_SUPERLATIVE, "plej rapide"},
+		{"hundo", WC_NOUN, MOD_PLURAL, "hundoj"},
+		{"hundo", WC_NOUN, MOD_POSSESSIVE, "de hundo"},
+		{"hundo", WC_NOUN, MOD_PLURAL | MOD_POSSESSIVE, "de hundoj"},
+		{"kuri", WC_VERB, MOD_NONE, "kuri"},
+		{"kuri", WC_VERB, MOD_PRESENT_SIMPLE, "kuras"},
+		{"kuri", WC_VERB, MOD_PAST_SIMPLE | MOD_PLURAL, "kuris"},
+		{"kuri", WC_VERB, MOD_FUTURE, "kuros"},
+		{"kuri", WC_VERB, MOD_INFINITIVE | MOD_NEGATIVE, "ne kuri"},
+		{"kuri", WC_VERB, MOD_PAST_PARTICIPLE, "kurita"},
+		{"kuri", WC_VERB, MOD_GERUND, "kurante"},
+		{"kuri", WC_VERB, MOD_PERFECT | MOD_PAST_SIMPLE, "estis kurinta"},
+		{"kuri", WC_VERB, MOD_PROGRESSIVE | MOD_PLURAL, "estas kurantaj"},
+		{"manĝi", WC_VERB, MOD_PASSIVE | MOD_FUTURE, "estos manĝata"},
+		{"manĝi", WC_VERB, MOD_PASSIVE | MOD_PERFECT, "estas manĝita"},
+		{"manĝi", WC_VERB, MOD_NEGATIVE_CONTRACTED, "ne manĝas"},
+		{"li", WC_PRONOUN, MOD_OBJECTIVE, "lin"},
+	}
+
+	var eo Esperanto
+
+	for _, c := range cases {
+		if output := eo.Inflect(Word{word: c.word}, c.wc, c.mods, InflectOptions{}); output != c.expected {
+			t.Errorf("Failed for '%s' (%s): expected '%s', got '%s'", c.word, c.mods, c.expected, output)
+		}
+	}
+}
+
+// Tests Esperanto.Determine. Fails if a determiner is incorrectly placed.
+func TestEsperanto_Determine(t *testing.T) {
+	cases := []struct {
+		input    string
+		mods     Mod
+		expected string
+	}{
+		{"hundo", MOD_INDEF, "hundo"},
+		{"hundo", MOD_DEF, "la hundo"},
+		{"hundo", MOD_EVERY, "ĉiu hundo"},
+		{"hundoj", MOD_SOME | MOD_PLURAL, "kelkaj hundoj"},
+		{"akvo", MOD_SOME, "iom da akvo"},
+		{"hundoj", MOD_MANY, "multaj hundoj"},
+		{"akvo", MOD_MUCH, "multe da akvo"},
+		{"de hundo", MOD_DEF, "de la hundo"},
+		{"de hundo", MOD_INDEF, "de hundo"},
+	}
+
+	var eo Esperanto
+
+	for _, c := range cases {
+		if output := eo.Determine(c.input, c.mods); output != c.expected {
+			t.Errorf("Failed for '%s' (%s): expected '%s', got '%s'", c.input, c.mods, c.expected, output)
+		}
+	}
+}
+
+// Tests Esperanto.Number. Fails if a number is incorrectly formatted.
+func TestEsperanto_Number(t *testing.T) {
+	cases := []struct {
+		n        int
+		mods     Mod
+		expected string
+	}{
+		{42, MOD_NONE, "42"},
+		{42, MOD_ORDINAL, "42-a"},
+		{0, MOD_SPELLED, "nul"},
+		{1, MOD_SPELLED | MOD_ORDINAL, "unua"},
+		{42, MOD_SPELLED, "kvardek du"},
+		{42, MOD_SPELLED | MOD_ORDINAL, "kvardek-dua"},
+		{110, MOD_SPELLED, "cent dek"},
+		{1984, MOD_SPELLED, "mil naŭcent okdek kvar"},
+		{-3000, MOD_SPELLED, "minus tri mil"},
+		{2_000_001, MOD_SPELLED, "du milionoj unu"},
+	}
+
+	var eo Esperanto
+
+	for _, c := range cases {
+		if output := eo.Number(c.n, c.mods); output != c.expected {
+			t.Errorf("Failed for %d (%s): expected '%s', got '%s'", c.n, c.mods, c.expected, output)
+		}
+	}
+}
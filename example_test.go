@@ -23,6 +23,8 @@ import (
 	"iter"
 	"log"
 	"math/rand/v2"
+	"os"
+	"strings"
 
 	"github.com/Zedran/neng"
 	"github.com/Zedran/neng/symbols"
@@ -44,6 +46,44 @@ func ExampleDefaultGenerator() {
 	fmt.Println(phrase2)
 }
 
+func ExampleDefaultGeneratorForLanguage() {
+	gen, _ := neng.DefaultGeneratorForLanguage(neng.Esperanto{}, nil)
+
+	fmt.Println(gen.Transform("hundo", neng.WC_NOUN, neng.MOD_PLURAL|neng.MOD_DEF))
+	fmt.Println(gen.Transform("kuri", neng.WC_VERB, neng.MOD_PROGRESSIVE|neng.MOD_PAST_SIMPLE))
+	// Output:
+	// la hundoj <nil>
+	// estis kuranta <nil>
+}
+
+func ExampleDefaultGeneratorWithOverlay() {
+	gopher, _ := neng.NewWord("0gopher")
+	grok, _ := neng.NewWord("0grok")
+
+	gen, err := neng.DefaultGeneratorWithOverlay(map[neng.WordClass]neng.Overlay{
+		neng.WC_NOUN: {
+			Add:    []neng.Word{gopher},
+			Remove: []string{"muffin"},
+		},
+		neng.WC_VERB: {
+			Add: []neng.Word{grok},
+		},
+	}, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = gen.Find("muffin", neng.WC_NOUN)
+	fmt.Println(err)
+
+	noun, _ := gen.Transform("gopher", neng.WC_NOUN, neng.MOD_PLURAL)
+	verb, _ := gen.Transform("grok", neng.WC_VERB, neng.MOD_PAST_SIMPLE)
+	fmt.Println(noun, verb)
+	// Output:
+	// no matches found
+	// gophers grokked
+}
+
 func ExampleGenerator_Adjective() {
 	gen, _ := neng.DefaultGenerator(nil)
 
@@ -75,6 +115,27 @@ func ExampleGenerator_All() {
 	// 3: abbatial
 }
 
+func ExampleGenerator_Batch() {
+	gen, _ := neng.DefaultGenerator(nil)
+
+	// Invite codes that differ by at least 3 edits
+	codes, _ := gen.Batch("%a-%n", 5, neng.BatchOptions{MinDistance: 3, Distance: neng.DIST_KEYBOARD})
+
+	for _, c := range codes {
+		fmt.Println(c)
+	}
+}
+
+func ExampleGenerator_ExportList() {
+	gen, _ := neng.NewGenerator([]string{"1good,better,best", "3big"}, []string{"0nicely"}, []string{"0moon"}, []string{"0exist"}, neng.DEFAULT_ITER_LIMIT, false, nil)
+
+	gen.ExportList(os.Stdout, neng.WC_ADJECTIVE, neng.LF_CSV)
+	// Output:
+	// word_class,word,form_type,irr1,irr2
+	// WC_ADJECTIVE,good,FT_IRREGULAR,better,best
+	// WC_ADJECTIVE,big,FT_SUFFIXED,,
+}
+
 func ExampleGenerator_Find() {
 	gen, _ := neng.DefaultGenerator(nil)
 
@@ -98,6 +159,68 @@ func ExampleGenerator_Find() {
 	// N: goes
 }
 
+func ExampleGenerator_Forms() {
+	gen, _ := neng.DefaultGenerator(nil)
+
+	noun, _ := gen.Find("goose", neng.WC_NOUN)
+
+	f, _ := gen.Forms(noun, neng.WC_NOUN)
+
+	fmt.Println(f.Base.Value, f.Plural.Value, f.PluralPossessive.Value, f.Gerund.NA)
+	// Output:
+	// goose geese geese's true
+}
+
+func ExampleGenerator_Lemmatize() {
+	gen, _ := neng.DefaultGenerator(nil)
+
+	lemmas, _ := gen.Lemmatize("geese")
+
+	for _, l := range lemmas {
+		fmt.Println(l.Word.Word(), l.WC, l.Mod)
+	}
+	// Output:
+	// goose WC_NOUN MOD_PLURAL
+}
+
+func ExampleGenerator_Lookup() {
+	gen, _ := neng.DefaultGenerator(nil)
+
+	entries, _ := gen.Lookup("light")
+
+	mods := map[neng.WordClass]neng.Mod{
+		neng.WC_ADJECTIVE: neng.MOD_COMPARATIVE,
+		neng.WC_NOUN:      neng.MOD_PLURAL,
+		neng.WC_VERB:      neng.MOD_PAST_SIMPLE,
+	}
+
+	for _, e := range entries {
+		w, _ := gen.TransformEntry(e, mods[e.WC])
+		fmt.Println(e.WC, w)
+	}
+	// Output:
+	// WC_ADJECTIVE lighter
+	// WC_NOUN lights
+	// WC_VERB lit
+}
+
+func ExampleGenerator_MarshalBinary() {
+	gen, _ := neng.DefaultGenerator(nil)
+
+	// Save the state before generating the phrase
+	state, _ := gen.MarshalBinary()
+	phrase, _ := gen.Phrase("%tn %Nv %a %pn")
+
+	// Restore the state in another Generator to replay the phrase
+	replay, _ := neng.DefaultGenerator(nil)
+	replay.UnmarshalBinary(state)
+	replayed, _ := replay.Phrase("%tn %Nv %a %pn")
+
+	fmt.Println(phrase == replayed)
+	// Output:
+	// true
+}
+
 func ExampleGenerator_Noun() {
 	gen, _ := neng.DefaultGenerator(nil)
 
@@ -105,6 +228,26 @@ func ExampleGenerator_Noun() {
 	fmt.Println(noun)
 }
 
+func ExampleGenerator_Number() {
+	gen, _ := neng.DefaultGenerator(nil)
+
+	ord, _ := gen.Number(42, 42, neng.MOD_ORDINAL)
+	spl, _ := gen.Number(42, 42, neng.MOD_SPELLED|neng.MOD_ORDINAL)
+
+	fmt.Println(ord, spl)
+	// Output:
+	// 42nd forty-second
+}
+
+func ExampleGenerator_Place() {
+	gen, _ := neng.DefaultGenerator(nil)
+
+	name, _ := gen.Surname(neng.MOD_NONE)
+	place, _ := gen.Place(neng.MOD_POSSESSIVE)
+
+	fmt.Printf("Captain %s of %s harbour\n", name, place)
+}
+
 func ExampleGenerator_Phrase() {
 	gen, _ := neng.DefaultGenerator(nil)
 
@@ -112,6 +255,84 @@ func ExampleGenerator_Phrase() {
 	fmt.Println(phrase)
 }
 
+func ExampleGenerator_SetForm() {
+	gen, _ := neng.DefaultGenerator(nil)
+
+	gen.SetForm("octopus", neng.WC_NOUN, neng.MOD_PLURAL, "octopodes")
+	gen.AddException(neng.EX_SINGLE, "gallop")
+
+	fmt.Println(gen.Transform("octopus", neng.WC_NOUN, neng.MOD_PLURAL|neng.MOD_DEF))
+	fmt.Println(gen.Transform("gallop", neng.WC_VERB, neng.MOD_PAST_SIMPLE))
+	// Output:
+	// the octopodes <nil>
+	// galloped <nil>
+}
+
+func ExampleGenerator_SetHomophones() {
+	gen, _ := neng.NewGenerator([]string{"3big"}, []string{"0nicely"}, []string{"0knight", "0night"}, []string{"0stash"}, neng.DEFAULT_ITER_LIMIT, false, nil)
+
+	gen.SetHomophones(neng.HP_DISTINCT)
+
+	// 'knight' and 'night' sound alike, so only one of them is inserted
+	_, err := gen.Phrase("%n %n")
+	fmt.Println(err)
+	// Output:
+	// iteration limit reached while trying to draw a comparable or countable word
+}
+
+func ExampleGenerator_SetMinPronounceability() {
+	gen, _ := neng.NewGenerator([]string{"3big"}, []string{"0nicely"}, []string{"0aalii", "0otter"}, []string{"0stash"}, neng.DEFAULT_ITER_LIMIT, false, nil)
+
+	gen.SetMinPronounceability(90)
+
+	fmt.Println(gen.Noun(neng.MOD_NONE))
+	// Output:
+	// otter <nil>
+}
+
+func ExampleGenerator_SetSpelling() {
+	gen, _ := neng.DefaultGenerator(nil)
+
+	gen.SetSpelling(neng.SP_BRITISH)
+
+	fmt.Println(gen.Transform("color", neng.WC_NOUN, neng.MOD_PLURAL))
+	fmt.Println(gen.Transform("travel", neng.WC_VERB, neng.MOD_GERUND))
+
+	gen.SetSpelling(neng.SP_AMERICAN)
+
+	fmt.Println(gen.Transform("travel", neng.WC_VERB, neng.MOD_GERUND))
+	// Output:
+	// colours <nil>
+	// travelling <nil>
+	// traveling <nil>
+}
+
+func ExampleGenerator_Search() {
+	gen, _ := neng.DefaultGenerator(nil)
+
+	words, _ := gen.Search("otte", neng.WC_NOUN, neng.SearchOptions{Prefix: true, MaxDistance: 1, Limit: 4})
+
+	for _, w := range words {
+		fmt.Println(w.Word())
+	}
+}
+
+func ExampleGenerator_Seed() {
+	gen, _ := neng.DefaultGenerator(nil)
+
+	// Record the seed of a randomly seeded Generator, e.g. for a bug report
+	seed1, seed2, _ := gen.Seed()
+	phrase, _ := gen.Phrase("%tn %Nv %a %pn")
+
+	// Recreate the Generator from the seed
+	gen2, _ := neng.DefaultGeneratorFromSeed(seed1, seed2)
+	phrase2, _ := gen2.Phrase("%tn %Nv %a %pn")
+
+	fmt.Println(phrase == phrase2)
+	// Output:
+	// true
+}
+
 func ExampleGenerator_Transform() {
 	gen, _ := neng.DefaultGenerator(nil)
 
@@ -180,9 +401,19 @@ func ExampleMod_Enabled() {
 	// false
 }
 
+func ExampleMod_String() {
+	mods := neng.MOD_PLURAL | neng.MOD_CASE_TITLE
+
+	fmt.Println(mods)
+	fmt.Println(neng.MOD_NONE)
+	// Output:
+	// MOD_PLURAL|MOD_CASE_TITLE
+	// MOD_NONE
+}
+
 func ExampleMod_Undefined() {
 	def := neng.MOD_GERUND
-	ndef := neng.Mod(65536)
+	ndef := neng.Mod(1 << 31)
 
 	fmt.Println(def.Undefined())
 	fmt.Println(ndef.Undefined())
@@ -218,6 +449,39 @@ func ExampleNewGenerator() {
 	// Non-comparable: iteration limit reached while trying to draw a comparable or countable word
 }
 
+func ExampleNewGeneratorASCII() {
+	words := func(lines ...string) []neng.Word {
+		list := make([]neng.Word, len(lines))
+		for i, ln := range lines {
+			list[i], _ = neng.NewWord(ln)
+		}
+		return list
+	}
+
+	gen, err := neng.NewGeneratorASCII(
+		words("0beautiful", "3big", "0well-known"),
+		words("0nicely"),
+		words("0jack-o'-lantern", "0otter"),
+		words("0look up", "0stash"),
+		neng.DEFAULT_ITER_LIMIT, false, nil,
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	sizes := gen.Sizes()
+	fmt.Println(sizes[neng.WC_ADJECTIVE], sizes[neng.WC_NOUN], sizes[neng.WC_VERB])
+
+	fmt.Println(gen.Transform("big", neng.WC_ADJECTIVE, neng.MOD_SUPERLATIVE))
+	fmt.Println(gen.Transform("beautiful", neng.WC_ADJECTIVE, neng.MOD_SUPERLATIVE))
+	fmt.Println(gen.Transform("otter", neng.WC_NOUN, neng.MOD_INDEF))
+	// Output:
+	// 2 1 1
+	// biggest <nil>
+	//  Mod(s) would add words or apostrophes to an ASCII-only word
+	//  Mod(s) would add words or apostrophes to an ASCII-only word
+}
+
 func ExampleNewGeneratorFromWord() {
 	a, _ := neng.NewWord("0inclined")
 	m, _ := neng.NewWord("0slowly")
@@ -276,6 +540,43 @@ func ExampleNewWordFromParams() {
 	// magnesium
 }
 
+func ExampleParseMod() {
+	// Names of Mod constants
+	m1, _ := neng.ParseMod("MOD_PLURAL|MOD_CASE_TITLE")
+
+	// Phrase transformation specifiers
+	m2, _ := neng.ParseMod("pt")
+
+	fmt.Println(m1 == m2)
+	// Output:
+	// true
+}
+
+func ExampleReadWordList() {
+	list := "# Custom verbs\r\n0compile\r\n\r\n1build,built,built\r\n"
+
+	words, err := neng.ReadWordList(strings.NewReader(list))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, w := range words {
+		fmt.Println(w.Word())
+	}
+	// Output:
+	// compile
+	// build
+}
+
+func ExampleWord_Phonetic() {
+	knight, _ := neng.NewWord("0knight")
+	night, _ := neng.NewWord("0night")
+
+	fmt.Println(knight.Phonetic(), night.Phonetic())
+	// Output:
+	// NT NT
+}
+
 func ExampleWord_Irr() {
 	word, _ := neng.NewWord("1good,better,best")
 
@@ -0,0 +1,322 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Exception identifies a table of words exempt from a regular inflection
+// rule of English. The tables are owned by the Generator, seeded with
+// the built-in exceptions and extended with Generator.AddException.
+type Exception uint8
+
+const (
+	// Verbs ending with a consonant, whose final consonant is doubled
+	// in past forms and gerund (regret -> regretted, bar -> barred)
+	// regardless of the spelling variant.
+	EX_DOUBLED Exception = iota
+
+	// Verbs ending with a consonant, whose final consonant is not doubled
+	// in past forms and gerund (kayak -> kayaked, limit -> limited).
+	// The entries are matched against the end of a verb, so that 'lyric'
+	// also covers 'relyric'.
+	EX_SINGLE
+
+	// Nouns whose plural form is identical to the singular. The entries
+	// are matched against the end of a noun, so that 'fish' also covers
+	// 'catfish'.
+	EX_UNCHANGED_PLURAL
+
+	// Nouns ending with '-man', whose plural ends with '-men'
+	// (policeman -> policemen). The entries are matched against the end
+	// of a noun, so that the built-in entry 'man' covers every such noun
+	// not listed in EX_MANS_PLURAL.
+	EX_MEN_PLURAL
+
+	// Nouns ending with '-man', which take the regular plural (caiman ->
+	// caimans, talisman -> talismans). The entries are matched against
+	// the end of a noun and take precedence over EX_MEN_PLURAL.
+	EX_MANS_PLURAL
+
+	// Internal value, declared to mark the end of usable Exception values.
+	ex_undefined
+)
+
+// Exceptions holds the tables of words exempt from the regular inflection
+// rules. A nil *Exceptions holds the built-in exceptions.
+type Exceptions struct {
+	tables [ex_undefined]map[string]struct{}
+}
+
+// builtinExceptions holds the exceptions known to the English inflection
+// rules.
+var builtinExceptions = newExceptions(map[Exception][]string{
+	EX_DOUBLED: {
+		"abet", "abhor", "anagram", "bar", "beget", "begin", "beset", "bestir",
+		"blur", "bur", "char", "concur", "confer", "curvet", "debar", "demur",
+		"deter", "disbar", "disinter", "forget", "incur", "inset", "jar", "mar",
+		"occur", "offset", "overrun", "par", "prefer", "quiz", "recur", "refer",
+		"regret", "reset", "revet", "scar", "sic", "slur", "spar", "spur", "star",
+		"stir", "sublet", "tar", "transfer", "typeset", "unbar", "underpin",
+		"underrun", "unpin", "up", "upset", "war",
+	},
+	EX_SINGLE: {
+		"batik", "kayak", "limit", "lyric", "orphan", "profit", "pyramid", "wedel",
+	},
+	EX_UNCHANGED_PLURAL: {
+		"craft", "fish",
+	},
+	EX_MEN_PLURAL: {
+		"man",
+	},
+	EX_MANS_PLURAL: {
+		"caiman", "human", "shaman", "talisman",
+	},
+})
+
+// newExceptions builds Exceptions from lists of words.
+func newExceptions(lists map[Exception][]string) *Exceptions {
+	var e Exceptions
+
+	for i := range e.tables {
+		e.tables[i] = make(map[string]struct{}, len(lists[Exception(i)]))
+		for _, w := range lists[Exception(i)] {
+			e.tables[i][w] = struct{}{}
+		}
+	}
+
+	return &e
+}
+
+// Contains returns true if word is listed in the table ex. Returns false
+// for undefined Exception values.
+func (e *Exceptions) Contains(ex Exception, word string) bool {
+	if ex >= ex_undefined {
+		return false
+	}
+	if e == nil {
+		e = builtinExceptions
+	}
+
+	_, ok := e.tables[ex][word]
+	return ok
+}
+
+// matchSuffix returns true if any entry of the table ex ends word.
+func (e *Exceptions) matchSuffix(ex Exception, word string) bool {
+	if e == nil {
+		e = builtinExceptions
+	}
+
+	for entry := range e.tables[ex] {
+		if strings.HasSuffix(word, entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// clone returns a deep copy of e.
+func (e *Exceptions) clone() *Exceptions {
+	if e == nil {
+		e = builtinExceptions
+	}
+
+	var c Exceptions
+	for i, t := range e.tables {
+		c.tables[i] = maps.Clone(t)
+	}
+	return &c
+}
+
+// InflectOptions holds the settings of a Generator that affect inflection:
+// the forms set with Generator.SetForm, as well as the spelling variant
+// and the exception tables of English. The settings are opaque -
+// the Generator passes them to Language.Inflect. The zero value selects
+// the default spelling and the built-in exceptions.
+type InflectOptions struct {
+	// Spelling variant selected with Generator.SetSpelling
+	spelling Spelling
+
+	// Exception tables of the Generator
+	exceptions *Exceptions
+
+	// Finds the head of a multi-word entry in the Generator's lists,
+	// in order to apply its irregular forms
+	find func(word string, wc WordClass) (Word, bool)
+
+	// Returns the form of the inflected word set with Generator.SetForm
+	// for a single inflection Mod. nil for auxiliaries.
+	form func(mods Mod) (string, bool)
+}
+
+// override returns the form of the inflected word set with
+// Generator.SetForm for mods, which must be one of the Mods returned
+// by inflectionMods.
+func (o InflectOptions) override(mods Mod) (string, bool) {
+	if o.form == nil {
+		return "", false
+	}
+	return o.form(mods)
+}
+
+// exception returns verb with tenseEnding appended and ok set to true,
+// if verb is listed in EX_DOUBLED or ends with an entry of EX_SINGLE.
+// The final consonant of the verbs from EX_DOUBLED is doubled.
+func (o InflectOptions) exception(verb, tenseEnding string) (form string, ok bool) {
+	switch true {
+	case o.exceptions.Contains(EX_DOUBLED, verb):
+		return doubleFinal(verb, tenseEnding), true
+	case o.exceptions.matchSuffix(EX_SINGLE, verb):
+		return verb + tenseEnding, true
+	}
+	return "", false
+}
+
+// formKey identifies a single form of a word in the table of form
+// overrides.
+type formKey struct {
+	word string
+	wc   WordClass
+	mods Mod
+}
+
+// AddException adds words to the table ex of the Generator's exceptions.
+// The exceptions apply to the words from any list, including the ones
+// added later, e.g. "gallop" added to EX_SINGLE yields "galloped".
+//
+// Returns symbols.ErrUndefinedException if ex is an undefined value.
+func (gen *Generator) AddException(ex Exception, words ...string) error {
+	return gen.modifyExceptions(ex, func(t map[string]struct{}) {
+		for _, w := range words {
+			t[w] = struct{}{}
+		}
+	})
+}
+
+// RemoveException removes words from the table ex of the Generator's
+// exceptions, including the built-in ones. Words not found in the table
+// are ignored.
+//
+// Returns symbols.ErrUndefinedException if ex is an undefined value.
+func (gen *Generator) RemoveException(ex Exception, words ...string) error {
+	return gen.modifyExceptions(ex, func(t map[string]struct{}) {
+		for _, w := range words {
+			delete(t, w)
+		}
+	})
+}
+
+// SetForm overrides a single form of a word, like the irregular forms
+// of FT_IRREGULAR words, but for any WordClass. mods selects one
+// of the forms inflected directly from the base word:
+//   - adjectives and adverbs - MOD_COMPARATIVE, MOD_SUPERLATIVE
+//   - nouns and surnames     - MOD_PLURAL
+//   - verbs                  - MOD_PAST_SIMPLE and MOD_PRESENT_SIMPLE,
+//     alone or with MOD_PLURAL, MOD_PAST_PARTICIPLE, MOD_GERUND
+//   - pronouns               - MOD_OBJECTIVE
+//
+// Like irregular forms, the override feeds the forms derived from it:
+// possessive forms, determiners, compound verb forms and case
+// transformations. The override of the past simple form applies
+// to its plural as well, unless the plural is overridden separately.
+// An empty form removes the override.
+//
+//	gen.SetForm("octopus", WC_NOUN, MOD_PLURAL, "octopodes")
+//	gen.Transform("octopus", WC_NOUN, MOD_MANY) // many octopodes
+//
+// Returns an error if:
+//   - undefined WordClass value is specified
+//   - mods parameter contains an undefined Mod value
+//   - mods does not select one of the above forms of wc
+func (gen *Generator) SetForm(word string, wc WordClass, mods Mod, form string) error {
+	switch true {
+	case wc >= wc_undefined:
+		return symbols.ErrUndefinedWordClass
+	case mods.Undefined():
+		return symbols.ErrUndefinedMod
+	case mods == MOD_NONE || !slices.Contains(inflectionMods(wc), mods):
+		return symbols.ErrIncompatible
+	}
+
+	gen.wmu.Lock()
+	defer gen.wmu.Unlock()
+
+	forms := make(map[formKey]string)
+	if old := gen.forms.Load(); old != nil {
+		maps.Copy(forms, *old)
+	}
+
+	key := formKey{word: word, wc: wc, mods: mods}
+	if len(form) == 0 {
+		delete(forms, key)
+	} else {
+		forms[key] = form
+	}
+
+	gen.forms.Store(&forms)
+	gen.refreshIndexes()
+
+	return nil
+}
+
+// modifyExceptions replaces the Generator's exceptions with a copy,
+// in which the table ex is modified by modify. Returns
+// symbols.ErrUndefinedException if ex is an undefined value.
+func (gen *Generator) modifyExceptions(ex Exception, modify func(map[string]struct{})) error {
+	if ex >= ex_undefined {
+		return symbols.ErrUndefinedException
+	}
+
+	gen.wmu.Lock()
+	defer gen.wmu.Unlock()
+
+	e := gen.exceptions.Load().clone()
+	modify(e.tables[ex])
+
+	gen.exceptions.Store(e)
+	gen.refreshIndexes()
+
+	return nil
+}
+
+// inflectOptions returns the current InflectOptions of the Generator
+// for word, a member of wc.
+func (gen *Generator) inflectOptions(word string, wc WordClass) InflectOptions {
+	forms := gen.forms.Load()
+
+	return InflectOptions{
+		spelling:   gen.Spelling(),
+		exceptions: gen.exceptions.Load(),
+		find: func(word string, wc WordClass) (Word, bool) {
+			return findWord(gen.lists.Load()[wc], word)
+		},
+		form: func(mods Mod) (string, bool) {
+			if forms == nil {
+				return "", false
+			}
+			form, ok := (*forms)[formKey{word: word, wc: wc, mods: mods}]
+			return form, ok
+		},
+	}
+}
@@ -0,0 +1,210 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests whether the built-in exceptions are applied. Fails if a word listed
+// in an exception table is inflected according to the regular rules.
+func TestBuiltinExceptions(t *testing.T) {
+	cases := []struct {
+		word     string
+		wc       WordClass
+		mods     Mod
+		expected string
+	}{
+		{"regret", WC_VERB, MOD_PAST_SIMPLE, "regretted"},
+		{"bar", WC_VERB, MOD_GERUND, "barring"},
+		{"kayak", WC_VERB, MOD_PAST_SIMPLE, "kayaked"},
+		{"limit", WC_VERB, MOD_GERUND, "limiting"},
+		{"fish", WC_NOUN, MOD_PLURAL, "fish"},
+		{"aircraft", WC_NOUN, MOD_PLURAL, "aircraft"},
+		{"policeman", WC_NOUN, MOD_PLURAL, "policemen"},
+	}
+
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	for _, c := range cases {
+		output, err := gen.Transform(c.word, c.wc, c.mods)
+		if err != nil {
+			t.Errorf("Failed for '%s': error returned: %v", c.word, err)
+		} else if output != c.expected {
+			t.Errorf("Failed for '%s': expected '%s', got '%s'", c.word, c.expected, output)
+		}
+	}
+}
+
+// Tests Generator.AddException and Generator.RemoveException. Fails if
+// the modified tables are not applied, the lemma index is not rebuilt,
+// another Generator is affected or an undefined value is accepted.
+func TestGenerator_AddException(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	other, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	if err := gen.AddException(EX_SINGLE, "gallop"); err != nil {
+		t.Fatalf("Failed: AddException returned an error: %v", err)
+	}
+
+	if output, _ := gen.Transform("gallop", WC_VERB, MOD_PAST_SIMPLE); output != "galloped" {
+		t.Errorf("Failed for 'gallop': expected 'galloped', got '%s'", output)
+	}
+
+	if output, _ := other.Transform("gallop", WC_VERB, MOD_PAST_SIMPLE); output != "gallopped" {
+		t.Errorf("Failed for 'gallop': another Generator affected, got '%s'", output)
+	}
+
+	if _, err := gen.Lemmatize("galloped"); err != nil {
+		t.Errorf("Failed: 'galloped' not lemmatized after AddException: %v", err)
+	}
+
+	if err := gen.RemoveException(EX_UNCHANGED_PLURAL, "fish"); err != nil {
+		t.Fatalf("Failed: RemoveException returned an error: %v", err)
+	}
+
+	if output, _ := gen.Transform("fish", WC_NOUN, MOD_PLURAL); output != "fishes" {
+		t.Errorf("Failed for 'fish': expected 'fishes', got '%s'", output)
+	}
+
+	desman, _ := NewWord("0desman")
+	if err := gen.AddWord(desman, WC_NOUN); err != nil {
+		t.Fatalf("Failed: AddWord returned an error: %v", err)
+	}
+
+	if output, _ := gen.Transform("desman", WC_NOUN, MOD_PLURAL); output != "desmen" {
+		t.Errorf("Failed for 'desman': expected 'desmen' before AddException, got '%s'", output)
+	}
+
+	if err := gen.AddException(EX_MANS_PLURAL, "desman"); err != nil {
+		t.Fatalf("Failed: AddException returned an error: %v", err)
+	}
+
+	if output, _ := gen.Transform("desman", WC_NOUN, MOD_PLURAL); output != "desmans" {
+		t.Errorf("Failed for 'desman': expected 'desmans', got '%s'", output)
+	}
+
+	if err := gen.AddException(ex_undefined, "gallop"); !errors.Is(err, symbols.ErrUndefinedException) {
+		t.Errorf("Failed: AddException: expected ErrUndefinedException, got %v", err)
+	}
+
+	if err := gen.RemoveException(ex_undefined, "gallop"); !errors.Is(err, symbols.ErrUndefinedException) {
+		t.Errorf("Failed: RemoveException: expected ErrUndefinedException, got %v", err)
+	}
+}
+
+// Tests Generator.SetForm. Fails if the override is not applied
+// with determiners and case transformations, the lemma index is not
+// rebuilt, an empty form does not remove the override or an invalid
+// argument is accepted.
+func TestGenerator_SetForm(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	if err := gen.SetForm("octopus", WC_NOUN, MOD_PLURAL, "octopodes"); err != nil {
+		t.Fatalf("Failed: SetForm returned an error: %v", err)
+	}
+
+	cases := []struct {
+		mods     Mod
+		expected string
+	}{
+		{MOD_PLURAL, "octopodes"},
+		{MOD_PLURAL | MOD_DEF | MOD_CASE_UPPER, "THE OCTOPODES"},
+		{MOD_PLURAL | MOD_POSSESSIVE, "octopodes'"},
+		{MOD_MANY, "many octopodes"},
+		{MOD_NONE, "octopus"},
+	}
+
+	for _, c := range cases {
+		output, err := gen.Transform("octopus", WC_NOUN, c.mods)
+		if err != nil {
+			t.Errorf("Failed for %d: error returned: %v", c.mods, err)
+		} else if output != c.expected {
+			t.Errorf("Failed for %d: expected '%s', got '%s'", c.mods, c.expected, output)
+		}
+	}
+
+	if err := gen.SetForm("run", WC_VERB, MOD_PAST_PARTICIPLE, "runned"); err != nil {
+		t.Fatalf("Failed: SetForm returned an error: %v", err)
+	}
+
+	verbCases := []struct {
+		mods     Mod
+		expected string
+	}{
+		{MOD_PAST_PARTICIPLE, "runned"},
+		{MOD_PERFECT, "has runned"},
+		{MOD_PASSIVE, "is runned"},
+		{MOD_PAST_SIMPLE, "ran"},
+	}
+
+	for _, c := range verbCases {
+		output, err := gen.Transform("run", WC_VERB, c.mods)
+		if err != nil {
+			t.Errorf("Failed for %d: error returned: %v", c.mods, err)
+		} else if output != c.expected {
+			t.Errorf("Failed for %d: expected '%s', got '%s'", c.mods, c.expected, output)
+		}
+	}
+
+	if _, err := gen.Lemmatize("octopodes"); err != nil {
+		t.Errorf("Failed: 'octopodes' not lemmatized after SetForm: %v", err)
+	}
+
+	if err := gen.SetForm("octopus", WC_NOUN, MOD_PLURAL, ""); err != nil {
+		t.Fatalf("Failed: SetForm returned an error: %v", err)
+	}
+
+	if output, _ := gen.Transform("octopus", WC_NOUN, MOD_PLURAL); output == "octopodes" {
+		t.Error("Failed: override not removed by an empty form")
+	}
+
+	errCases := []struct {
+		wc   WordClass
+		mods Mod
+		err  error
+	}{
+		{wc_undefined, MOD_NONE, symbols.ErrUndefinedWordClass},
+		{WC_NOUN, mod_undefined, symbols.ErrUndefinedMod},
+		{WC_NOUN, MOD_GERUND, symbols.ErrIncompatible},
+		{WC_NOUN, MOD_NONE, symbols.ErrIncompatible},
+		{WC_NOUN, MOD_PLURAL | MOD_DEF, symbols.ErrIncompatible},
+	}
+
+	for _, c := range errCases {
+		if err := gen.SetForm("octopus", c.wc, c.mods, "x"); !errors.Is(err, c.err) {
+			t.Errorf("Failed for %d, %d: expected '%v', got '%v'", c.wc, c.mods, c.err, err)
+		}
+	}
+}
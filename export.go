@@ -0,0 +1,262 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// ListFormat specifies the format of exported and imported word lists.
+type ListFormat uint8
+
+const (
+	// Native word list format, accepted by NewGenerator and ReadWordList.
+	// A single line holds a single Word (see NewGenerator). When multiple
+	// lists are written, each of them is preceded by a comment line holding
+	// the name of its WordClass, e.g. "# WC_NOUN".
+	LF_NATIVE ListFormat = iota
+
+	// JSON format. A single list is encoded as an array of Word objects
+	// (see Word.MarshalJSON). Multiple lists are encoded as an object
+	// mapping WordClass names to such arrays.
+	LF_JSON
+
+	// CSV format with a header row. Every record consists of the following
+	// fields: WordClass name, word, FormType name, irregular form 1,
+	// irregular form 2. Missing irregular forms are left empty.
+	LF_CSV
+)
+
+// csvHeader is the header row of the CSV format.
+var csvHeader = []string{"word_class", "word", "form_type", "irr1", "irr2"}
+
+// Export writes all word lists of the Generator to w in the specified format.
+// WC_PLACE, which has no word list, is omitted.
+// The output can be read back with Import. Returns an error if lf is
+// undefined. Relays errors from w.
+func (gen *Generator) Export(w io.Writer, lf ListFormat) error {
+	lists := gen.lists.Load()
+
+	switch lf {
+	case LF_NATIVE:
+		bw := bufio.NewWriter(w)
+		for wc, list := range lists.listed() {
+			fmt.Fprintf(bw, "# %s\n", wc)
+			writeNative(bw, list)
+		}
+		return bw.Flush()
+	case LF_JSON:
+		m := make(map[string][]Word, len(lists))
+		for wc, list := range lists.listed() {
+			m[wc.String()] = list
+		}
+		return json.NewEncoder(w).Encode(m)
+	case LF_CSV:
+		cw := csv.NewWriter(w)
+		cw.Write(csvHeader)
+		for wc, list := range lists.listed() {
+			writeCSV(cw, wc, list)
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		return symbols.ErrUndefinedListFormat
+	}
+}
+
+// ExportList writes the word list corresponding to wc to w in the specified
+// format. In LF_NATIVE format, the WordClass header is omitted, so the output
+// can be read with ReadWordList. Returns an error if wc or lf is undefined
+// or if wc is WC_PLACE.
+// Relays errors from w.
+func (gen *Generator) ExportList(w io.Writer, wc WordClass, lf ListFormat) error {
+	list, err := gen.getList(wc)
+	if err != nil {
+		return err
+	}
+
+	switch lf {
+	case LF_NATIVE:
+		bw := bufio.NewWriter(w)
+		writeNative(bw, list)
+		return bw.Flush()
+	case LF_JSON:
+		return json.NewEncoder(w).Encode(list)
+	case LF_CSV:
+		cw := csv.NewWriter(w)
+		cw.Write(csvHeader)
+		writeCSV(cw, wc, list)
+		cw.Flush()
+		return cw.Error()
+	default:
+		return symbols.ErrUndefinedListFormat
+	}
+}
+
+// Import reads word lists written by Generator.Export or Generator.ExportList
+// in the specified format and groups them by WordClass. Within each list,
+// the words keep the order in which they were read, so the lists written
+// by Generator.Export can be passed to NewGeneratorFromWord.
+//
+// Returns an error if:
+//   - lf is undefined
+//   - the input is malformed
+//   - any of the records holds an undefined WordClass or FormType name
+//   - any of the records holds an invalid Word (see NewWordFromParams)
+//   - LF_NATIVE input contains a Word that is not preceded
+//     by a WordClass header
+//   - LF_JSON input contains a single list, in which case WordClass
+//     is unknown
+func Import(r io.Reader, lf ListFormat) (map[WordClass][]Word, error) {
+	switch lf {
+	case LF_NATIVE:
+		return importNative(r)
+	case LF_JSON:
+		var m map[string][]Word
+		if err := json.NewDecoder(r).Decode(&m); err != nil {
+			return nil, err
+		}
+
+		lists := make(map[WordClass][]Word, len(m))
+		for name, list := range m {
+			wc, err := parseWordClass(name)
+			if err != nil {
+				return nil, err
+			}
+			lists[wc] = list
+		}
+		return lists, nil
+	case LF_CSV:
+		return importCSV(r)
+	default:
+		return nil, symbols.ErrUndefinedListFormat
+	}
+}
+
+// importCSV reads word lists in LF_CSV format.
+func importCSV(r io.Reader) (map[WordClass][]Word, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = len(csvHeader)
+
+	lists := make(map[WordClass][]Word)
+
+	for n := 1; ; n++ {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return lists, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if n == 1 && rec[0] == csvHeader[0] {
+			continue
+		}
+
+		wc, err := parseWordClass(rec[0])
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", n, err)
+		}
+
+		ft, err := parseFormType(rec[2])
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", n, err)
+		}
+
+		var irr []string
+		for _, f := range rec[3:] {
+			if len(f) > 0 {
+				irr = append(irr, f)
+			}
+		}
+
+		w, err := NewWordFromParams(rec[1], ft, irr)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", n, err)
+		}
+
+		lists[wc] = append(lists[wc], w)
+	}
+}
+
+// importNative reads word lists in LF_NATIVE format.
+func importNative(r io.Reader) (map[WordClass][]Word, error) {
+	var (
+		current WordClass
+		header  bool
+		lists   = make(map[WordClass][]Word)
+	)
+
+	err := scanLines(r, func(line string) error {
+		if line[0] == '#' {
+			if name, ok := strings.CutPrefix(line, "# "); ok {
+				if wc, err := parseWordClass(name); err == nil {
+					current, header = wc, true
+				}
+			}
+			return nil
+		}
+
+		if !header {
+			return symbols.ErrUndefinedWordClass
+		}
+
+		w, err := NewWord(line)
+		if err != nil {
+			return err
+		}
+
+		lists[current] = append(lists[current], w)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+// writeCSV writes list to cw as LF_CSV records.
+func writeCSV(cw *csv.Writer, wc WordClass, list []Word) {
+	rec := make([]string, len(csvHeader))
+
+	for _, w := range list {
+		rec[0], rec[1], rec[2], rec[3], rec[4] = wc.String(), w.word, w.ft.String(), "", ""
+		if w.irr != nil {
+			copy(rec[3:], *w.irr)
+		}
+		cw.Write(rec)
+	}
+}
+
+// writeNative writes list to bw in LF_NATIVE format, one Word per line.
+func writeNative(bw *bufio.Writer, list []Word) {
+	for _, w := range list {
+		bw.WriteString(w.String())
+		bw.WriteByte('\n')
+	}
+}
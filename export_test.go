@@ -0,0 +1,117 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests whether word lists exported with Generator.Export can be imported
+// without any loss of information in every ListFormat.
+func TestExport(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	for _, lf := range []ListFormat{LF_NATIVE, LF_JSON, LF_CSV} {
+		var buf bytes.Buffer
+
+		if err := gen.Export(&buf, lf); err != nil {
+			t.Fatalf("Failed for ListFormat %d: Export returned an error: %v", lf, err)
+		}
+
+		lists, err := Import(&buf, lf)
+		if err != nil {
+			t.Fatalf("Failed for ListFormat %d: Import returned an error: %v", lf, err)
+		}
+
+		for wc, expected := range gen.lists.Load() {
+			if !slices.EqualFunc(lists[WordClass(wc)], expected, func(a, b Word) bool {
+				return a.word == b.word && sameForms(a, b)
+			}) {
+				t.Errorf("Failed for ListFormat %d: list %s differs after import", lf, WordClass(wc))
+			}
+		}
+	}
+
+	if err = gen.Export(&bytes.Buffer{}, ListFormat(255)); !errors.Is(err, symbols.ErrUndefinedListFormat) {
+		t.Errorf("Failed: undefined ListFormat was not rejected: %v", err)
+	}
+}
+
+// Tests whether Generator.ExportList writes a single list in the expected
+// form for every ListFormat.
+func TestExportList(t *testing.T) {
+	gen, err := NewGenerator([]string{"1good,better,best", "3big"}, []string{"0nicely"}, []string{"0snowfall"}, []string{"0stash"}, DEFAULT_ITER_LIMIT, false, nil)
+	if err != nil {
+		t.Fatalf("Failed: NewGenerator returned an error: %v", err)
+	}
+
+	expected := map[ListFormat]string{
+		LF_NATIVE: "1good,better,best\n3big\n",
+		LF_JSON:   `[{"word":"good","form_type":"FT_IRREGULAR","irr":["better","best"]},{"word":"big","form_type":"FT_SUFFIXED"}]` + "\n",
+		LF_CSV:    "word_class,word,form_type,irr1,irr2\nWC_ADJECTIVE,good,FT_IRREGULAR,better,best\nWC_ADJECTIVE,big,FT_SUFFIXED,,\n",
+	}
+
+	for lf, e := range expected {
+		var buf bytes.Buffer
+
+		if err := gen.ExportList(&buf, WC_ADJECTIVE, lf); err != nil {
+			t.Errorf("Failed for ListFormat %d: ExportList returned an error: %v", lf, err)
+		} else if buf.String() != e {
+			t.Errorf("Failed for ListFormat %d: expected:\n%s\ngot:\n%s", lf, e, buf.String())
+		}
+	}
+
+	if err = gen.ExportList(&bytes.Buffer{}, WordClass(255), LF_NATIVE); !errors.Is(err, symbols.ErrUndefinedWordClass) {
+		t.Errorf("Failed: undefined WordClass was not rejected: %v", err)
+	}
+}
+
+// Tests whether Import rejects malformed input.
+func TestImport(t *testing.T) {
+	type testCase struct {
+		lf    ListFormat
+		input string
+	}
+
+	cases := []testCase{
+		{LF_NATIVE, "0big\n"},                                      // No WordClass header
+		{LF_NATIVE, "# WC_ADJECTIVE\n1big\n"},                      // Malformed line
+		{LF_JSON, `[{"word":"big","form_type":"FT_SUFFIXED"}]`},    // Single list
+		{LF_JSON, `{"WC_UNKNOWN":[]}`},                             // Undefined WordClass
+		{LF_JSON, `{"WC_NOUN":[{"word":"a","form_type":"FT_X"}]}`}, // Undefined FormType
+		{LF_CSV, "WC_NOUN,box,FT_REGULAR,boxes,\n"},                // Irregular form for regular word
+		{LF_CSV, "WC_NOUN,box,FT_REGULAR\n"},                       // Missing fields
+		{LF_CSV, "WC_X,box,FT_REGULAR,,\n"},                        // Undefined WordClass
+		{ListFormat(255), ""},                                      // Undefined ListFormat
+	}
+
+	for i, c := range cases {
+		if out, err := Import(strings.NewReader(c.input), c.lf); err == nil {
+			t.Errorf("Failed for case %d: error not returned, got %v", i, out)
+		}
+	}
+}
@@ -17,6 +17,13 @@
 
 package neng
 
+import (
+	"fmt"
+	"slices"
+
+	"github.com/Zedran/neng/symbols"
+)
+
 // FormType (formation type). Indicates the effect that
 // grammatical transformations have on a given word.
 type FormType uint8
@@ -51,3 +58,57 @@ const (
 	// An attempt to pluralize an uncountable noun results in an error.
 	FT_UNCOUNTABLE
 )
+
+// ftNames holds the names of FormType constants, indexed by their values.
+var ftNames = [...]string{
+	"FT_REGULAR", "FT_IRREGULAR", "FT_PLURAL_ONLY",
+	"FT_SUFFIXED", "FT_NON_COMPARABLE", "FT_UNCOUNTABLE",
+}
+
+// MarshalText implements encoding.TextMarshaler. The FormType is encoded
+// as the name of its constant. Returns symbols.ErrUndefinedFormType if ft holds
+// an undefined value.
+func (ft FormType) MarshalText() ([]byte, error) {
+	if int(ft) >= len(ftNames) {
+		return nil, symbols.ErrUndefinedFormType
+	}
+	return []byte(ftNames[ft]), nil
+}
+
+// String returns the name of the FormType constant, e.g. "FT_IRREGULAR".
+// For undefined values, returns "FormType(n)".
+func (ft FormType) String() string {
+	if int(ft) < len(ftNames) {
+		return ftNames[ft]
+	}
+	return fmt.Sprintf("FormType(%d)", ft)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. In addition to the string
+// produced by FormType.MarshalText, it accepts the numeric value
+// of the FormType. Returns symbols.ErrUndefinedFormType if the value is undefined.
+func (ft *FormType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, ft, func(ft FormType) bool { return int(ft) >= len(ftNames) }, symbols.ErrUndefinedFormType, ft.UnmarshalText)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the name
+// of a FormType constant. Returns symbols.ErrUndefinedFormType if the name
+// is not recognized.
+func (ft *FormType) UnmarshalText(text []byte) error {
+	v, err := parseFormType(string(text))
+	if err != nil {
+		return err
+	}
+
+	*ft = v
+	return nil
+}
+
+// parseFormType returns FormType corresponding to the name of its constant.
+// Returns symbols.ErrUndefinedFormType if name is not recognized.
+func parseFormType(name string) (FormType, error) {
+	if i := slices.Index(ftNames[:], name); i != -1 {
+		return FormType(i), nil
+	}
+	return 0, fmt.Errorf("'%s': %w", name, symbols.ErrUndefinedFormType)
+}
@@ -0,0 +1,114 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Form is a single inflected form of a word, an element of Forms.
+type Form struct {
+	// Transformed word. Empty if NA is true.
+	Value string
+
+	// True if the form does not apply to the word, either because
+	// of its WordClass or its FormType (e.g. the plural of an uncountable
+	// noun or the comparative of a non-comparable adjective).
+	NA bool
+}
+
+// Forms is an inflection table of a single word, returned by Generator.Forms.
+// Base is always applicable. The remaining fields are grouped by
+// the WordClass they apply to and are marked as not applicable for other
+// WordClasses.
+type Forms struct {
+	// Base form of the word
+	Base Form
+
+	// Nouns and names
+	Plural           Form
+	Possessive       Form
+	PluralPossessive Form
+
+	// Verbs
+	ThirdPerson    Form
+	PastSimple     Form
+	PastParticiple Form
+	Gerund         Form
+
+	// Adjectives and adverbs
+	Comparative Form
+	Superlative Form
+
+	// Pronouns
+	Objective Form
+}
+
+// Forms returns the inflection table of word, treated as a member of wc.
+// Forms that are incompatible with wc, as well as those rejected due to
+// the word's FormType, are marked as not applicable (e.g. the singular
+// possessive of a plural-only noun). Verb forms are given
+// for the 3rd person singular.
+//
+// Returns symbols.ErrUndefinedWordClass if wc is not a valid WordClass value.
+func (gen *Generator) Forms(word Word, wc WordClass) (Forms, error) {
+	if wc >= wc_undefined {
+		return Forms{}, symbols.ErrUndefinedWordClass
+	}
+
+	var f Forms
+
+	table := []struct {
+		dst  *Form
+		mods Mod
+	}{
+		{&f.Base, MOD_NONE},
+		{&f.Plural, MOD_PLURAL},
+		{&f.Possessive, MOD_POSSESSIVE},
+		{&f.PluralPossessive, MOD_PLURAL | MOD_POSSESSIVE},
+		{&f.ThirdPerson, MOD_PRESENT_SIMPLE},
+		{&f.PastSimple, MOD_PAST_SIMPLE},
+		{&f.PastParticiple, MOD_PAST_PARTICIPLE},
+		{&f.Gerund, MOD_GERUND},
+		{&f.Comparative, MOD_COMPARATIVE},
+		{&f.Superlative, MOD_SUPERLATIVE},
+		{&f.Objective, MOD_OBJECTIVE},
+	}
+
+	for _, e := range table {
+		if word.ft == FT_PLURAL_ONLY && e.mods == MOD_POSSESSIVE {
+			// Plural-only nouns have no singular possessive form
+			e.dst.NA = true
+			continue
+		}
+
+		tw, err := gen.TransformWord(word, wc, e.mods)
+		if err != nil {
+			if !errors.Is(err, symbols.ErrIncompatible) && !errors.Is(err, symbols.ErrNonComparable) && !errors.Is(err, symbols.ErrUncountable) && !errors.Is(err, symbols.ErrSingularOnly) {
+				return Forms{}, err
+			}
+			e.dst.NA = true
+			continue
+		}
+		e.dst.Value = tw
+	}
+
+	return f, nil
+}
@@ -0,0 +1,82 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Zedran/neng/internal/tests"
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests whether Generator.Forms builds correct inflection tables
+// and marks inapplicable forms. Inapplicable forms are denoted
+// by "-" in the test data.
+func TestGenerator_Forms(t *testing.T) {
+	type testCase struct {
+		Word  string    `json:"word"`
+		WC    WordClass `json:"word_class"`
+		Forms []string  `json:"forms"`
+	}
+
+	var cases []testCase
+	if err := tests.ReadData("TestForms.json", &cases); err != nil {
+		t.Fatalf("Failed loading test data: %v", err)
+	}
+
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	for _, c := range cases {
+		w, err := gen.Find(c.Word, c.WC)
+		if err != nil {
+			t.Errorf("Failed for '%s': Find returned an error: %v", c.Word, err)
+			continue
+		}
+
+		f, err := gen.Forms(w, c.WC)
+		if err != nil {
+			t.Errorf("Failed for '%s': error returned: %v", c.Word, err)
+			continue
+		}
+
+		out := []Form{
+			f.Base, f.Plural, f.Possessive, f.PluralPossessive, f.ThirdPerson,
+			f.PastSimple, f.PastParticiple, f.Gerund, f.Comparative, f.Superlative,
+			f.Objective,
+		}
+
+		for i, o := range out {
+			expected := Form{Value: c.Forms[i]}
+			if c.Forms[i] == "-" {
+				expected = Form{NA: true}
+			}
+
+			if o != expected {
+				t.Errorf("Failed for '%s', form %d: expected %+v, got %+v", c.Word, i, expected, o)
+			}
+		}
+	}
+
+	if _, err := gen.Forms(Word{word: "x"}, wc_undefined); !errors.Is(err, symbols.ErrUndefinedWordClass) {
+		t.Errorf("Failed: expected ErrUndefinedWordClass, got %v", err)
+	}
+}
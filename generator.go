@@ -18,12 +18,16 @@
 package neng
 
 import (
+	"encoding/binary"
 	"fmt"
+	"io/fs"
 	"iter"
 	"math/rand/v2"
+	"path"
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/Zedran/neng/symbols"
 )
@@ -52,21 +56,53 @@ const DEFAULT_ITER_LIMIT int = 1000
 
 // Generator creates and transforms random phrases or words.
 type Generator struct {
-	// List of adjectives
-	adj []Word
+	// Current snapshot of the word lists. Methods modifying the lists
+	// replace it atomically, so that readers are never blocked.
+	lists atomic.Pointer[wordLists]
 
-	// List of adverbs
-	adv []Word
+	// Mutex serializing modifications of the word lists
+	wmu sync.Mutex
 
-	// List of nouns
-	noun []Word
+	// Reverse index of inflected forms, built by Generator.Lemmatize
+	lemmas lazyIndex[lemmaIndex]
 
-	// List of verbs
-	verb []Word
+	// Grammar of the generated words
+	lang Language
+
+	// Stems and suffixes of place names
+	places *morphemes
 
 	// Case transformation handler
 	caser caser
 
+	// Spelling variant, stored as uint32 to allow atomic access
+	spelling atomic.Uint32
+
+	// Exceptions to the inflection rules. nil selects the built-in ones.
+	exceptions atomic.Pointer[Exceptions]
+
+	// Overrides of individual forms, set with Generator.SetForm
+	forms atomic.Pointer[map[formKey]string]
+
+	// Homophone policy, stored as uint32 to allow atomic access
+	homophones atomic.Uint32
+
+	// Index of phonetic keys, used by HP_EXCLUDE
+	phonetic lazyIndex[homophoneIndex]
+
+	// Minimum pronounceability score of the drawn words
+	minScore atomic.Uint32
+
+	// Pronounceability scores of the listed words, used by minScore
+	scores lazyIndex[scoreIndex]
+
+	// BK-trees used by Generator.Search
+	search lazyIndex[*searchIndex]
+
+	// Restriction to single ASCII words, set by DefaultGeneratorASCII
+	// and NewGeneratorASCII
+	ascii atomic.Bool
+
 	// A safeguard for Generator.generateModifier and Generator.Noun methods.
 	// Refer to DEFAULT_ITER_LIMIT in Constants section for more information.
 	iterLimit int
@@ -74,10 +110,39 @@ type Generator struct {
 	// Source of random numbers
 	source rand.Rand
 
-	// Mutex for source
+	// PCG underlying source, if it was created by the Generator.
+	// nil if the source was provided by the user.
+	pcg *rand.PCG
+
+	// Seed used to initialize pcg
+	seed [2]uint64
+
+	// Mutex for source, pcg and seed
 	mu sync.Mutex
 }
 
+// AddWord inserts w into the word list corresponding to wc, maintaining
+// the alphabetical order. It is safe to call AddWord concurrently with
+// other methods - phrases generated during the modification are drawn
+// from the previous version of the list. If an identical entry already
+// exists, the list is not modified.
+//
+// Returns an error if:
+//   - undefined WordClass value or WC_PLACE is specified
+//   - the list contains the same word with a different FormType
+//     or different irregular forms
+//   - the Generator is restricted to ASCII words and w
+//     contains characters other than ASCII letters
+func (gen *Generator) AddWord(w Word, wc WordClass) error {
+	if err := gen.checkASCII(w); err != nil {
+		return err
+	}
+
+	return gen.modifyList(wc, func(list []Word) ([]Word, error) {
+		return insertWord(slices.Clone(list), w)
+	})
+}
+
 // Adjective generates a single random adjective and transforms it
 // according to mods.
 //
@@ -87,7 +152,7 @@ type Generator struct {
 //   - Generator.iterLimit is reached while attempting to generate a comparable
 //     adjective (relevant for generators with customized word lists)
 func (gen *Generator) Adjective(mods Mod) (string, error) {
-	return gen.generateModifier(WC_ADJECTIVE, mods)
+	return gen.generateModifier(WC_ADJECTIVE, mods, gen.accept(nil))
 }
 
 // Adverb generates a single random adverb and transforms it according to mods.
@@ -98,7 +163,7 @@ func (gen *Generator) Adjective(mods Mod) (string, error) {
 //   - Generator.iterLimit is reached while attempting to generate a comparable
 //     adverb (relevant for generators with customized word lists)
 func (gen *Generator) Adverb(mods Mod) (string, error) {
-	return gen.generateModifier(WC_ADVERB, mods)
+	return gen.generateModifier(WC_ADVERB, mods, gen.accept(nil))
 }
 
 // All returns an iterator that yields index-Word pairs from the Generator's
@@ -112,7 +177,9 @@ func (gen *Generator) All(wc WordClass) (iter.Seq2[int, Word], error) {
 }
 
 // Find searches the word list for the specified word. Returns an error if
-// word is not found or if WordClass is undefined.
+// word is not found or if WordClass is undefined or WC_PLACE.
+// Generator.Suggest offers similar words if symbols.ErrNotFound
+// is returned.
 //
 // Assumes the following about the 'word' argument:
 //   - Word is lower case
@@ -125,12 +192,8 @@ func (gen *Generator) Find(word string, wc WordClass) (Word, error) {
 		return Word{}, err
 	}
 
-	n, found := slices.BinarySearchFunc(list, word, func(listItem Word, word string) int {
-		return strings.Compare((listItem).word, word)
-	})
-
-	if found {
-		return list[n], nil
+	if w, found := findWord(list, word); found {
+		return w, nil
 	}
 
 	return Word{}, symbols.ErrNotFound
@@ -142,6 +205,33 @@ func (gen *Generator) Len(wc WordClass) (int, error) {
 	return len(list), err
 }
 
+// MarshalBinary implements encoding.BinaryMarshaler. It returns the seed
+// and the current state of the Generator's source of random numbers.
+// The snapshot can be restored with Generator.UnmarshalBinary to replay
+// the sequence of generated words from this point onward.
+//
+// Returns symbols.ErrExternalSource if the Generator was created with
+// a user-provided source of random numbers.
+func (gen *Generator) MarshalBinary() ([]byte, error) {
+	gen.mu.Lock()
+	defer gen.mu.Unlock()
+
+	if gen.pcg == nil {
+		return nil, symbols.ErrExternalSource
+	}
+
+	state, err := gen.pcg.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, 16+len(state))
+	data = binary.BigEndian.AppendUint64(data, gen.seed[0])
+	data = binary.BigEndian.AppendUint64(data, gen.seed[1])
+
+	return append(data, state...), nil
+}
+
 // Noun generates a single random noun and transforms it according to mods.
 //
 // Returns an error if:
@@ -151,23 +241,7 @@ func (gen *Generator) Len(wc WordClass) (int, error) {
 //     noun	for MOD_PLURAL, or a countable, not plural-only noun for MOD_INDEF
 //     (relevant for generators with customized word lists)
 func (gen *Generator) Noun(mods Mod) (string, error) {
-	var excluded []FormType
-
-	if mods.Enabled(MOD_PLURAL) {
-		excluded = []FormType{FT_UNCOUNTABLE}
-	} else if mods.Enabled(MOD_INDEF | MOD_INDEF_SILENT) {
-		excluded = []FormType{FT_PLURAL_ONLY, FT_UNCOUNTABLE}
-	} else {
-		excluded = []FormType{FT_PLURAL_ONLY}
-	}
-
-	for range gen.iterLimit {
-		if n := gen.noun[gen.randIndex(len(gen.noun))]; !slices.Contains(excluded, n.ft) {
-			return gen.TransformWord(n, WC_NOUN, mods)
-		}
-	}
-
-	return "", symbols.ErrIterLimit
+	return gen.noun(mods, gen.accept(nil))
 }
 
 // Phrase generates a phrase given a pattern.
@@ -184,25 +258,52 @@ func (gen *Generator) Noun(mods Mod) (string, error) {
 //
 //	Insertions:
 //		%% - inserts % sign, use it only to create a freestanding %
+//		#  - inserts a random number from range DEFAULT_NUM_MIN-DEFAULT_NUM_MAX,
+//		     the range can be specified in braces: #{1-100}
 //		a - inserts a random adjective
+//		e - inserts a random preposition
+//		h - inserts a random interjection
+//		j - inserts a random conjunction
+//		k - inserts a random given name
 //		m - inserts a random adverb
 //		n - inserts a random noun
+//		r - inserts a random pronoun
 //		v - inserts a random verb
+//		y - inserts a random surname
+//		z - inserts a random place name
 //
 //	Transformations:
 //		2 - transforms a verb into its Past Simple form (2nd form)
 //		3 - transforms a verb into its Past Participle form (3rd form)
+//		E - inserts 'every' before an adjective or a noun
+//		F - precedes a verb with 'will' (future)
+//		G - transforms a verb into its progressive form (is running)
+//		H - transforms a verb into its perfect form (has run)
+//		I - precedes a verb with 'to' (infinitive)
+//		M - inserts 'many' before an adjective or a noun
 //		N - transforms a verb into its Present Simple form (now)
+//		O - transforms a pronoun into its objective case (him)
+//		R - transforms a number into an ordinal (42nd)
+//		S - inserts 'some' before an adjective or a noun
+//		U - inserts 'much' before an adjective or a noun
+//		W - spells out a number in words (forty-two)
+//		X - negates a verb using contracted form (doesn't run)
+//		b - transforms a verb into passive voice (is eaten)
 //		c - transforms an adjective or an adverb into comparative (better)
+//		d - inserts the definite article before an adjective or a noun
 //		g - transforms a verb into gerund
 //		i - inserts an indefinite article before an adjective, adverb or a noun
 //		_ - silent indefinite (refer to README for information)
-//		p - transforms a noun or a verb (Present Simple) into its plural form
+//		~ - silent determiner (refer to README for information)
+//		o - transforms a noun or a name into its possessive form
+//		p - transforms a noun or a verb (Present Simple) into its plural form,
+//		    picks a plural pronoun, pluralizes a surname
 //		s - transforms an adjective or an adverb into superlative (best)
 //		f - transforms a word to Sentence case
 //		l - transforms a word to lower case
 //		t - transforms a word to Title Case
 //		u - transforms a word to UPPER CASE
+//		x - negates a verb (does not run)
 //
 // Error is returned if:
 //   - provided pattern is empty
@@ -211,6 +312,16 @@ func (gen *Generator) Noun(mods Mod) (string, error) {
 //   - transformation specifier ends the group ("%t2 - bad, %t2v - ok")
 //   - transformation modifier assigned to a word is not compatible with
 //     its WordClass
+//   - number range specification is malformed or its minimum exceeds
+//     its maximum
+//   - a word permitted by the homophone policy (Generator.SetHomophones)
+//     and the minimum pronounceability (Generator.SetMinPronounceability)
+//     cannot be drawn within the iteration limit
+//
+// A noun that follows a cardinal number agrees with it - it is transformed
+// into plural, unless the number is 1 or -1. Only spaces, adjectives
+// and adverbs may separate the noun from the number. The adjectives
+// agree with the number as well, if the Language requires it.
 //
 // Example pattern:
 //
@@ -233,9 +344,33 @@ func (gen *Generator) Phrase(pattern string) (string, error) {
 
 		// Built phrase
 		phrase strings.Builder
+
+		// If true, the next noun must agree with count. Cleared by literal
+		// text other than spaces and by insertions other than modifiers.
+		agree bool
+
+		// The most recently inserted cardinal number
+		count int
+
+		// Index of the first character following number range specification
+		skip int
+
+		// Phonetic keys of the inserted words, recorded under HP_DISTINCT
+		// and HP_EXCLUDE
+		seen map[string]struct{}
 	)
 
+	if gen.Homophones() != HP_ALLOW {
+		seen = make(map[string]struct{})
+	}
+
+	accept := gen.accept(seen)
+
 	for i, c := range pattern {
+		if i < skip {
+			continue
+		}
+
 		if escaped {
 			switch c {
 			case '%':
@@ -243,14 +378,59 @@ func (gen *Generator) Phrase(pattern string) (string, error) {
 					return "", symbols.ErrUndefinedSpecifier
 				}
 				phrase.WriteRune(c)
+				agree = false
 				escaped = false
-			case '2', '3', 'N', 'c', 'f', 'g', 'i', 'l', 'o', 'p', 's', 't', 'u', '_':
+			case '2', '3', 'E', 'F', 'G', 'H', 'I', 'M', 'N', 'O', 'R', 'S', 'U', 'W', 'X', 'b', 'c', 'd', 'f', 'g', 'i', 'l', 'o', 'p', 's', 't', 'u', 'x', '_', '~':
 				if i == len(pattern)-1 {
 					return "", symbols.ErrSpecStrTerm
 				}
 				mods |= specToMod(c)
-			case 'a', 'm', 'n', 'v':
-				word, err := gen.getGenerator(c)(mods)
+			case '#':
+				lo, hi := DEFAULT_NUM_MIN, DEFAULT_NUM_MAX
+
+				if strings.HasPrefix(pattern[i+1:], "{") {
+					end := strings.IndexByte(pattern[i+1:], '}')
+					if end == -1 {
+						return "", symbols.ErrBadRange
+					}
+
+					var err error
+					if lo, hi, err = parseRange(pattern[i+2 : i+1+end]); err != nil {
+						return "", err
+					}
+					skip = i + 2 + end
+				}
+
+				num, n, err := gen.number(lo, hi, mods)
+				if err != nil {
+					return "", err
+				}
+				phrase.WriteString(num)
+
+				agree, count = !mods.Enabled(MOD_ORDINAL), n
+				escaped = false
+			case 'a', 'e', 'h', 'j', 'k', 'm', 'n', 'r', 'v', 'y', 'z':
+				generate := gen.getGenerator(c)
+
+				if agree {
+					switch c {
+					case 'n':
+						mods = agreeWith(count, mods)
+						agree = false
+					case 'a':
+						// Adjectives may precede the agreeing noun
+						// and agree with it in number
+						if count != 1 && count != -1 {
+							generate = gen.pluralAdjective
+						}
+					case 'm':
+						// Adverbs may precede the agreeing noun
+					default:
+						agree = false
+					}
+				}
+
+				word, err := generate(mods, accept)
 				if err != nil {
 					return "", err
 				}
@@ -267,6 +447,10 @@ func (gen *Generator) Phrase(pattern string) (string, error) {
 			escaped = true
 			mods = MOD_NONE
 		} else {
+			if c != ' ' {
+				// Literal text ends the agreement
+				agree = false
+			}
 			phrase.WriteRune(c)
 		}
 	}
@@ -274,6 +458,116 @@ func (gen *Generator) Phrase(pattern string) (string, error) {
 	return phrase.String(), nil
 }
 
+// RemoveWord removes word from the list corresponding to wc. It is safe
+// to call RemoveWord concurrently with other methods - phrases generated
+// during the modification are drawn from the previous version of the list.
+//
+// Returns an error if:
+//   - undefined WordClass value or WC_PLACE is specified
+//   - word is not present in the list
+//   - removal would leave the list empty
+func (gen *Generator) RemoveWord(word string, wc WordClass) error {
+	return gen.modifyList(wc, func(list []Word) ([]Word, error) {
+		list, err := removeWord(slices.Clone(list), word)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(list) == 0 {
+			return nil, symbols.ErrEmptyLists
+		}
+		return list, nil
+	})
+}
+
+// ReplaceList replaces the entire word list corresponding to wc with a copy
+// of list. The copy is sorted if necessary. It is safe to call ReplaceList
+// concurrently with other methods - phrases generated during
+// the modification are drawn from the previous version of the list.
+//
+// As with NewGeneratorFromWord, it is assumed that the Word structs
+// are created using one of the safe constructors.
+//
+// Returns an error if undefined WordClass value or WC_PLACE is specified,
+// if list is empty or if the Generator is restricted to ASCII words and list
+// contains words with characters other than ASCII letters.
+func (gen *Generator) ReplaceList(list []Word, wc WordClass) error {
+	if len(list) == 0 {
+		return symbols.ErrEmptyLists
+	}
+
+	for _, w := range list {
+		if err := gen.checkASCII(w); err != nil {
+			return err
+		}
+	}
+
+	return gen.modifyList(wc, func([]Word) ([]Word, error) {
+		list = slices.Clone(list)
+		if !slices.IsSortedFunc(list, cmpWord) {
+			slices.SortFunc(list, cmpWord)
+		}
+		return list, nil
+	})
+}
+
+// Reseed replaces the Generator's source of random numbers with a new
+// rand.PCG initialized with seed1 and seed2. Generators reseeded with
+// the same values and given the same sequence of calls produce the same
+// results.
+func (gen *Generator) Reseed(seed1, seed2 uint64) {
+	gen.mu.Lock()
+	defer gen.mu.Unlock()
+
+	gen.pcg = rand.NewPCG(seed1, seed2)
+	gen.seed = [2]uint64{seed1, seed2}
+	gen.source = *rand.New(gen.pcg)
+}
+
+// Seed returns the values used to seed the Generator's source of random
+// numbers. Combined with Generator.Reseed or DefaultGeneratorFromSeed,
+// it allows to recreate the sequence of words generated by a Generator.
+//
+// Returns symbols.ErrExternalSource if the Generator was created with
+// a user-provided source of random numbers.
+func (gen *Generator) Seed() (uint64, uint64, error) {
+	gen.mu.Lock()
+	defer gen.mu.Unlock()
+
+	if gen.pcg == nil {
+		return 0, 0, symbols.ErrExternalSource
+	}
+
+	return gen.seed[0], gen.seed[1], nil
+}
+
+// SetSpelling selects the spelling variant of the words returned
+// by the Generator. The variant affects both the base words, which are
+// mapped through a table of variants (color -> colour), and the inflection
+// (traveled, travelled). The words in the lists are not modified - Find
+// and Words still report their original spelling. Lemmatize indexes
+// the forms spelled with the current variant.
+//
+// Returns symbols.ErrUndefinedSpelling if sp is an undefined value.
+func (gen *Generator) SetSpelling(sp Spelling) error {
+	if sp > SP_BRITISH {
+		return symbols.ErrUndefinedSpelling
+	}
+
+	gen.wmu.Lock()
+	defer gen.wmu.Unlock()
+
+	gen.spelling.Store(uint32(sp))
+	gen.refreshIndexes()
+
+	return nil
+}
+
+// Spelling returns the spelling variant currently used by the Generator.
+func (gen *Generator) Spelling() Spelling {
+	return Spelling(gen.spelling.Load())
+}
+
 // Transform searches (Generator.Find) for the specified word and, if found,
 // calls Generator.TransformWord to transform it.
 //
@@ -285,7 +579,7 @@ func (gen *Generator) Phrase(pattern string) (string, error) {
 //
 // Returns an error if:
 //   - word of the WordClass wc does not exist in the database
-//   - undefined WordClass value is specified
+//   - undefined WordClass value or WC_PLACE is specified
 //
 // Relays an error from Generator.TransformWord if:
 //   - WordClass of the word is not compatible with any Mod in mods
@@ -302,7 +596,9 @@ func (gen *Generator) Transform(word string, wc WordClass, mods Mod) (string, er
 }
 
 // TransformWord modifies the Word according to specified mods.
-// Not all mods are compatible with every WordClass.
+// Not all mods are compatible with every WordClass. The word is inflected
+// by the Language of the Generator. English respells adjectives, adverbs,
+// nouns and verbs according to Generator.Spelling.
 //
 // Assumes the following about Word.word:
 //   - It is lower case
@@ -317,12 +613,24 @@ func (gen *Generator) Transform(word string, wc WordClass, mods Mod) (string, er
 //   - transformation into comparative or superlative form is requested
 //     for a non-comparable adjective or adverb
 //   - transformation into plural form is requested for an uncountable noun
+//   - the Generator is restricted to ASCII words and mods
+//     would add words or apostrophes, or word is not eligible
 func (gen *Generator) TransformWord(word Word, wc WordClass, mods Mod) (string, error) {
+	return gen.transformWord(word, wc, mods, MOD_NONE)
+}
+
+// transformWord is the implementation of Generator.TransformWord.
+// agreement holds the Mods passed to the Language along with mods after
+// their validation, e.g. MOD_PLURAL for adjectives that precede a noun
+// agreeing with a plural number.
+func (gen *Generator) transformWord(word Word, wc WordClass, mods, agreement Mod) (string, error) {
+	if wc.name() && !mods.Enabled(mod_case) {
+		mods |= MOD_CASE_TITLE
+	}
+
 	switch true {
-	case wc > WC_VERB:
+	case wc >= wc_undefined:
 		return "", symbols.ErrUndefinedWordClass
-	case mods == MOD_NONE:
-		return word.word, nil
 	case mods.Undefined():
 		return "", symbols.ErrUndefinedMod
 	case !wc.CompatibleWith(mods):
@@ -335,10 +643,10 @@ func (gen *Generator) TransformWord(word Word, wc WordClass, mods Mod) (string,
 			return "", symbols.ErrNonComparable
 		}
 	case WC_NOUN:
-		if word.ft == FT_UNCOUNTABLE && mods.Enabled(MOD_PLURAL) {
+		if word.ft == FT_UNCOUNTABLE && mods.Enabled(MOD_PLURAL|MOD_MANY) {
 			return "", symbols.ErrUncountable
 		}
-		if mods.Enabled(MOD_INDEF | MOD_INDEF_SILENT) {
+		if mods.Enabled(MOD_INDEF | MOD_INDEF_SILENT | MOD_EVERY) {
 			if word.ft == FT_PLURAL_ONLY {
 				return "", symbols.ErrPluralOnly
 			}
@@ -346,66 +654,102 @@ func (gen *Generator) TransformWord(word Word, wc WordClass, mods Mod) (string,
 				return "", symbols.ErrUncountable
 			}
 		}
+		if word.ft != FT_UNCOUNTABLE && (mods.Enabled(MOD_MUCH) || mods.Enabled(MOD_SOME) && !mods.Enabled(MOD_PLURAL) && word.ft != FT_PLURAL_ONLY) {
+			return "", symbols.ErrCountable
+		}
+	case WC_PRONOUN:
+		if word.ft != FT_PLURAL_ONLY && mods.Enabled(MOD_PLURAL) {
+			return "", symbols.ErrSingularOnly
+		}
 	}
 
-	var w string
-
-	switch wc {
-	case WC_ADJECTIVE, WC_ADVERB:
-		if mods.Enabled(MOD_COMPARATIVE) {
-			w = comparative(word)
-		} else if mods.Enabled(MOD_SUPERLATIVE) {
-			w = superlative(word)
-		}
-	case WC_NOUN:
-		if mods.Enabled(MOD_PLURAL) {
-			w = plural(word)
-			if mods.Enabled(MOD_POSSESSIVE) {
-				w = possessive(w, true)
-			}
-		} else if mods.Enabled(MOD_POSSESSIVE) {
-			w = possessive(word.word, false)
+	if gen.ascii.Load() {
+		if mods.Enabled(mod_spaced) || word.ft == FT_REGULAR && mods.Enabled(MOD_COMPARATIVE|MOD_SUPERLATIVE) {
+			return "", symbols.ErrSpacedMod
 		}
-	case WC_VERB:
-		if mods.Enabled(MOD_PAST_SIMPLE) {
-			w = pastSimple(word, mods.Enabled(MOD_PLURAL))
-		} else if mods.Enabled(MOD_PAST_PARTICIPLE) {
-			w = pastParticiple(word)
-		} else if mods.Enabled(MOD_PRESENT_SIMPLE) {
-			w = presentSimple(word.word, mods.Enabled(MOD_PLURAL))
-		} else if mods.Enabled(MOD_GERUND) {
-			w = gerund(word.word)
+		if err := gen.checkASCII(word); err != nil {
+			return "", err
 		}
 	}
 
-	if len(w) == 0 {
-		// If no mods other than case transformation
-		// or indefinite are requested, w remains empty
-		w = word.word
+	w := gen.lang.Inflect(word, wc, mods|agreement, gen.inflectOptions(word.word, wc))
+
+	if !mods.Enabled(MOD_DET_SILENT) {
+		w = gen.lang.Determine(w, mods|agreement)
 	}
 
-	if mods.Enabled(MOD_INDEF) {
-		w = indefinite(w)
+	if gen.ascii.Load() && !isASCIIWord(w) {
+		return "", symbols.ErrNonASCII
 	}
 
-	switch true {
-	case mods.Enabled(MOD_CASE_LOWER):
-		w = gen.caser.toLower(w)
-	case mods.Enabled(MOD_CASE_SENTENCE):
-		w = gen.caser.toSentence(w)
-	case mods.Enabled(MOD_CASE_TITLE):
-		w = gen.caser.toTitle(w)
-	case mods.Enabled(MOD_CASE_UPPER):
-		w = gen.caser.toUpper(w)
+	return gen.caser.apply(w, mods), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It restores
+// the seed and the state of the source of random numbers from a snapshot
+// created by Generator.MarshalBinary. If the Generator was created with
+// a user-provided source, that source is replaced.
+//
+// Returns symbols.ErrBadState if data is not a valid snapshot.
+func (gen *Generator) UnmarshalBinary(data []byte) error {
+	if len(data) < 16 {
+		return symbols.ErrBadState
 	}
 
-	return w, nil
+	pcg := &rand.PCG{}
+	if err := pcg.UnmarshalBinary(data[16:]); err != nil {
+		return fmt.Errorf("%w: %w", symbols.ErrBadState, err)
+	}
+
+	gen.mu.Lock()
+	defer gen.mu.Unlock()
+
+	gen.pcg = pcg
+	gen.seed = [2]uint64{binary.BigEndian.Uint64(data), binary.BigEndian.Uint64(data[8:])}
+	gen.source = *rand.New(pcg)
+
+	return nil
+}
+
+// Conjunction generates a single random conjunction and transforms it
+// according to mods. Returns an error if an undefined or incompatible
+// Mod is received.
+func (gen *Generator) Conjunction(mods Mod) (string, error) {
+	return gen.generateWord(WC_CONJUNCTION, mods, gen.accept(nil))
+}
+
+// Interjection generates a single random interjection and transforms it
+// according to mods. Returns an error if an undefined or incompatible
+// Mod is received.
+func (gen *Generator) Interjection(mods Mod) (string, error) {
+	return gen.generateWord(WC_INTERJECTION, mods, gen.accept(nil))
+}
+
+// Preposition generates a single random preposition and transforms it
+// according to mods. Returns an error if an undefined or incompatible
+// Mod is received.
+func (gen *Generator) Preposition(mods Mod) (string, error) {
+	return gen.generateWord(WC_PREPOSITION, mods, gen.accept(nil))
+}
+
+// Pronoun generates a single random pronoun and transforms it according
+// to mods. If MOD_PLURAL is enabled, a pronoun taking plural verb forms
+// (they) is picked, otherwise a singular one (she).
+//
+// Returns an error if:
+//   - an undefined Mod is received (relays from Generator.TransformWord)
+//   - an incompatible Mod is received (relays from Generator.TransformWord)
+//   - Generator.iterLimit is reached while attempting to generate a pronoun
+//     of the requested number (relevant for generators with customized
+//     word lists)
+func (gen *Generator) Pronoun(mods Mod) (string, error) {
+	return gen.pronoun(mods, gen.accept(nil))
 }
 
 // Verb generates a single random verb and transforms it according to mods.
 // Returns an error if an undefined Mod is received.
 func (gen *Generator) Verb(mods Mod) (string, error) {
-	return gen.TransformWord(gen.verb[gen.randIndex(len(gen.verb))], WC_VERB, mods)
+	return gen.generateWord(WC_VERB, mods, gen.accept(nil))
 }
 
 // Words returns an iterator that yields words from the Generator's list
@@ -418,6 +762,89 @@ func (gen *Generator) Words(wc WordClass) (iter.Seq[Word], error) {
 	return slices.Values(list), nil
 }
 
+// accept returns a function that reports whether a drawn Word may be used
+// under the homophone policy and the minimum pronounceability score
+// of the Generator. If seen is not nil, the phonetic keys of the accepted
+// words are recorded in it and words whose keys are already present
+// are rejected. Returns nil if any word is accepted.
+func (gen *Generator) accept(seen map[string]struct{}) func(Word) bool {
+	hp, minScore := gen.Homophones(), gen.MinPronounceability()
+
+	if seen == nil && hp != HP_EXCLUDE && minScore == 0 {
+		return nil
+	}
+
+	var index homophoneIndex
+	if hp == HP_EXCLUDE {
+		index = gen.homophoneIndex()
+	}
+
+	var scores scoreIndex
+	if minScore > 0 {
+		scores = gen.scoreIndex()
+	}
+
+	return func(w Word) bool {
+		if minScore > 0 {
+			score, ok := scores[w.word]
+			if !ok {
+				// Added after the index was retrieved
+				score = uint8(w.Pronounceability())
+			}
+			if int(score) < minScore {
+				return false
+			}
+		}
+
+		if index == nil && seen == nil {
+			return true
+		}
+
+		key := homophoneKey(w.word)
+
+		if index != nil && index[key] > 1 {
+			return false
+		}
+
+		if seen != nil {
+			if _, ok := seen[key]; ok {
+				return false
+			}
+			seen[key] = struct{}{}
+		}
+		return true
+	}
+}
+
+// draw picks a random word from items that satisfies both valid and accept.
+// Either function can be nil, in which case it is not consulted. If both
+// are nil, a single word is drawn. Returns symbols.ErrIterLimit
+// if Generator.iterLimit is reached before a suitable word is found.
+func (gen *Generator) draw(items []Word, valid, accept func(Word) bool) (Word, error) {
+	if valid == nil && accept == nil {
+		return items[gen.randIndex(len(items))], nil
+	}
+
+	for range gen.iterLimit {
+		if w := items[gen.randIndex(len(items))]; (valid == nil || valid(w)) && (accept == nil || accept(w)) {
+			return w, nil
+		}
+	}
+
+	return Word{}, symbols.ErrIterLimit
+}
+
+// generateWord picks a random word accepted by accept from the list
+// corresponding to wc and transforms it according to mods. Relays errors
+// from Generator.draw and Generator.TransformWord.
+func (gen *Generator) generateWord(wc WordClass, mods Mod, accept func(Word) bool) (string, error) {
+	w, err := gen.draw(gen.lists.Load()[wc], nil, accept)
+	if err != nil {
+		return "", err
+	}
+	return gen.TransformWord(w, wc, mods)
+}
+
 // generateModifier is a common method used to generate adjectives
 // (noun modifiers) and adverbs (adjective and verb modifiers).
 //
@@ -426,64 +853,184 @@ func (gen *Generator) Words(wc WordClass) (iter.Seq[Word], error) {
 //   - an incompatible Mod is received (relays from Generator.TransformWord)
 //   - Generator.iterLimit is reached while attempting to generate
 //     a comparable adjective or adverb
-func (gen *Generator) generateModifier(wc WordClass, mods Mod) (string, error) {
-	var items []Word
+func (gen *Generator) generateModifier(wc WordClass, mods Mod, accept func(Word) bool) (string, error) {
+	w, err := gen.drawModifier(wc, mods, accept)
+	if err != nil {
+		return "", err
+	}
+	return gen.TransformWord(w, wc, mods)
+}
 
-	if wc == WC_ADJECTIVE {
-		items = gen.adj
-	} else {
-		items = gen.adv
+// drawModifier draws an adjective or an adverb that can be transformed
+// according to mods. Relays errors from Generator.draw.
+func (gen *Generator) drawModifier(wc WordClass, mods Mod, accept func(Word) bool) (Word, error) {
+	var valid func(Word) bool
+
+	if mods.Enabled(MOD_COMPARATIVE | MOD_SUPERLATIVE) {
+		if gen.ascii.Load() {
+			valid = func(w Word) bool { return w.ft == FT_SUFFIXED || w.ft == FT_IRREGULAR }
+		} else {
+			valid = func(w Word) bool { return w.ft != FT_NON_COMPARABLE }
+		}
 	}
 
-	if !mods.Enabled(MOD_COMPARATIVE | MOD_SUPERLATIVE) {
-		return gen.TransformWord(items[gen.randIndex(len(items))], wc, mods)
+	return gen.draw(gen.lists.Load()[wc], valid, accept)
+}
+
+// pluralAdjective works like Generator.Adjective, but the adjective
+// precedes a noun agreeing with a plural number. Languages in which
+// adjectives agree with nouns inflect it accordingly (Esperanto belaj),
+// English adjectives remain unchanged.
+func (gen *Generator) pluralAdjective(mods Mod, accept func(Word) bool) (string, error) {
+	w, err := gen.drawModifier(WC_ADJECTIVE, mods, accept)
+	if err != nil {
+		return "", err
 	}
+	return gen.transformWord(w, WC_ADJECTIVE, mods, MOD_PLURAL)
+}
 
-	for range gen.iterLimit {
-		if a := items[gen.randIndex(len(items))]; a.ft != FT_NON_COMPARABLE {
-			return gen.TransformWord(a, wc, mods)
-		}
+// noun is the implementation of Generator.Noun, which only draws words
+// accepted by accept.
+func (gen *Generator) noun(mods Mod, accept func(Word) bool) (string, error) {
+	excluded := nounExclusions(mods)
+
+	n, err := gen.draw(gen.lists.Load()[WC_NOUN], func(w Word) bool {
+		return !slices.Contains(excluded, w.ft)
+	}, accept)
+	if err != nil {
+		return "", err
 	}
+	return gen.TransformWord(n, WC_NOUN, mods)
+}
 
-	return "", symbols.ErrIterLimit
+// pronoun is the implementation of Generator.Pronoun, which only draws
+// words accepted by accept.
+func (gen *Generator) pronoun(mods Mod, accept func(Word) bool) (string, error) {
+	plural := mods.Enabled(MOD_PLURAL)
+
+	p, err := gen.draw(gen.lists.Load()[WC_PRONOUN], func(w Word) bool {
+		return (w.ft == FT_PLURAL_ONLY) == plural
+	}, accept)
+	if err != nil {
+		return "", err
+	}
+	return gen.TransformWord(p, WC_PRONOUN, mods)
 }
 
 // getGenerator is a helper method that was created to shorten the loop in
 // Generator.Phrase. It accepts an insertion command character and returns
-// the corresponding generator method. nil is never returned as this method
-// is only called when a valid insertion command is encountered.
-func (gen *Generator) getGenerator(flag rune) func(Mod) (string, error) {
+// the corresponding generator method, which only draws words accepted
+// by its second argument. nil is never returned as this method is only
+// called when a valid insertion command is encountered.
+func (gen *Generator) getGenerator(flag rune) func(Mod, func(Word) bool) (string, error) {
 	switch flag {
 	case 'a':
-		return gen.Adjective
+		return func(mods Mod, accept func(Word) bool) (string, error) {
+			return gen.generateModifier(WC_ADJECTIVE, mods, accept)
+		}
+	case 'e':
+		return gen.wordGenerator(WC_PREPOSITION)
+	case 'h':
+		return gen.wordGenerator(WC_INTERJECTION)
+	case 'j':
+		return gen.wordGenerator(WC_CONJUNCTION)
+	case 'k':
+		return gen.wordGenerator(WC_GIVEN_NAME)
 	case 'm':
-		return gen.Adverb
+		return func(mods Mod, accept func(Word) bool) (string, error) {
+			return gen.generateModifier(WC_ADVERB, mods, accept)
+		}
 	case 'n':
-		return gen.Noun
+		return gen.noun
+	case 'r':
+		return gen.pronoun
 	case 'v':
-		return gen.Verb
+		return gen.wordGenerator(WC_VERB)
+	case 'y':
+		return gen.wordGenerator(WC_SURNAME)
+	case 'z':
+		return gen.place
 	default:
 		return nil
 	}
 }
 
+// wordGenerator returns Generator.generateWord bound to wc.
+func (gen *Generator) wordGenerator(wc WordClass) func(Mod, func(Word) bool) (string, error) {
+	return func(mods Mod, accept func(Word) bool) (string, error) {
+		return gen.generateWord(wc, mods, accept)
+	}
+}
+
+// refreshIndexes stores a new snapshot of the word lists, so that
+// the indexes built from them are rebuilt after a change of the settings
+// affecting inflection. The caller must hold gen.wmu.
+func (gen *Generator) refreshIndexes() {
+	lists := *gen.lists.Load()
+	gen.lists.Store(&lists)
+}
+
+// findWord performs a binary search for word in list.
+func findWord(list []Word, word string) (Word, bool) {
+	n, found := slices.BinarySearchFunc(list, word, func(listItem Word, word string) int {
+		return strings.Compare((listItem).word, word)
+	})
+
+	if found {
+		return list[n], true
+	}
+	return Word{}, false
+}
+
 // getList is a helper method that returns a word list corresponding to wc
-// or an error if an undefined WordClass value is received.
+// or an error if an undefined WordClass value or WC_PLACE is received.
 func (gen *Generator) getList(wc WordClass) ([]Word, error) {
-	switch wc {
-	case WC_ADJECTIVE:
-		return gen.adj, nil
-	case WC_ADVERB:
-		return gen.adv, nil
-	case WC_NOUN:
-		return gen.noun, nil
-	case WC_VERB:
-		return gen.verb, nil
+	if err := checkListed(wc); err != nil {
+		return nil, err
+	}
+	return gen.lists.Load()[wc], nil
+}
+
+// checkListed returns an error if wc is undefined or if it is WC_PLACE,
+// which has no word list.
+func checkListed(wc WordClass) error {
+	switch {
+	case wc >= wc_undefined:
+		return symbols.ErrUndefinedWordClass
+	case wc == WC_PLACE:
+		return symbols.ErrNoWordList
 	default:
-		return nil, symbols.ErrUndefinedWordClass
+		return nil
 	}
 }
 
+// modifyList replaces the word list corresponding to wc with the result
+// of modify, which receives the current version of the list. modify must
+// not alter the received slice. Modifications are serialized, while
+// readers keep using the previous snapshot until the new one is stored.
+// Returns an error if undefined WordClass value or WC_PLACE is specified.
+// Relays errors from modify, in which case the list is not replaced.
+func (gen *Generator) modifyList(wc WordClass, modify func([]Word) ([]Word, error)) error {
+	if err := checkListed(wc); err != nil {
+		return err
+	}
+
+	gen.wmu.Lock()
+	defer gen.wmu.Unlock()
+
+	lists := *gen.lists.Load()
+
+	list, err := modify(lists[wc])
+	if err != nil {
+		return err
+	}
+
+	lists[wc] = list
+	gen.lists.Store(&lists)
+
+	return nil
+}
+
 // randIndex returns a random index [0, length). Does not check for 0 (panic) -
 // NewGenerator does not allow empty slices.
 func (gen *Generator) randIndex(length int) int {
@@ -503,27 +1050,48 @@ func (gen *Generator) randIndex(length int) int {
 // by neng. The error value remains exposed in case of future changes
 // in the implementation of embed.
 func DefaultGenerator(src *rand.Rand) (*Generator, error) {
-	a, err := readEFS("embed/adj")
-	if err != nil {
-		return nil, err
-	}
+	return NewGeneratorFromFS(efs, "embed/adj", "embed/adv", "embed/noun", "embed/verb", DEFAULT_ITER_LIMIT, false, src)
+}
 
-	m, err := readEFS("embed/adv")
-	if err != nil {
-		return nil, err
+// DefaultGeneratorForLanguage returns a new Generator speaking lang,
+// with the word lists of its embedded language pack. The packs are
+// selected by the base of lang's tag, e.g. "eo" for Esperanto.
+//
+// src is the source of random numbers. If src is nil, a new, randomly seeded
+// rand.PCG is created.
+//
+// Returns symbols.ErrUnsupportedLanguage if there is no embedded language
+// pack for lang.
+func DefaultGeneratorForLanguage(lang Language, src *rand.Rand) (*Generator, error) {
+	dir, ok := embeddedDirs[baseCode(lang)]
+	if !ok {
+		return nil, symbols.ErrUnsupportedLanguage
 	}
 
-	n, err := readEFS("embed/noun")
-	if err != nil {
-		return nil, err
+	var lists [4][]Word
+
+	for i, name := range []string{"adj", "adv", "noun", "verb"} {
+		words, err := readWordFile(efs, path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		lists[i] = words
 	}
 
-	v, err := readEFS("embed/verb")
+	return NewGeneratorForLanguage(lang, lists[0], lists[1], lists[2], lists[3], DEFAULT_ITER_LIMIT, false, src)
+}
+
+// DefaultGeneratorFromSeed returns a new Generator with default word lists
+// and a source of random numbers created with rand.NewPCG(seed1, seed2).
+// Generators created with the same seed produce the same sequence of words.
+func DefaultGeneratorFromSeed(seed1, seed2 uint64) (*Generator, error) {
+	gen, err := DefaultGenerator(nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewGenerator(a, m, n, v, DEFAULT_ITER_LIMIT, false, src)
+	gen.Reseed(seed1, seed2)
+	return gen, nil
 }
 
 // NewGenerator initializes a Generator with the provided lists.
@@ -585,19 +1153,37 @@ func NewGenerator(adj, adv, noun, verb []string, iterLimit int, safe bool, src *
 	return NewGeneratorFromWord(wAdj, wAdv, wNoun, wVerb, iterLimit, safe, src)
 }
 
-// NewGeneratorFromWord returns Generator created using the provided lists
-// of Word structs and iterLimit. Returns an error if any of the lists is
-// empty. If safe is false, empty / nil checks are omitted.
-// It is assumed that Word structs are created using one of
-// the safe constructors, therefore their validity is not verified. Those
-// constructors do not check word case though - all words should be lower
-// case. Every slice must be sorted A-Z by Word.word field. If safe is true,
-// the function ensures the correct order. iterLimit is an adjustable safety
-// mechanism to prevent inifinite loops during certain transformations. For
-// more information, refer to DEFAULT_ITER_LIMIT in the section 'Constants'.
-// src is the source of random numbers. If src is nil, a new, randomly seeded
-// rand.PCG is created.
-func NewGeneratorFromWord(adj, adv, noun, verb []Word, iterLimit int, safe bool, src *rand.Rand) (*Generator, error) {
+// NewGeneratorFromFS initializes a Generator with the word lists read from
+// files at the specified paths within fsys. To read files from the
+// operating system's file system, use os.DirFS. The files are parsed
+// with ReadWordList and must follow the line structure described
+// in NewGenerator.
+//
+// Returns an error if any of the files cannot be opened or read, or if
+// it contains a malformed line. The error includes the path of the file
+// and the number of the offending line.
+//
+// The meaning of iterLimit, safe and src is the same as in NewGenerator.
+func NewGeneratorFromFS(fsys fs.FS, adj, adv, noun, verb string, iterLimit int, safe bool, src *rand.Rand) (*Generator, error) {
+	var lists [4][]Word
+
+	for i, path := range []string{adj, adv, noun, verb} {
+		words, err := readWordFile(fsys, path)
+		if err != nil {
+			return nil, err
+		}
+		lists[i] = words
+	}
+
+	return NewGeneratorFromWord(lists[0], lists[1], lists[2], lists[3], iterLimit, safe, src)
+}
+
+// NewGeneratorForLanguage works like NewGeneratorFromWord, but the returned
+// Generator inflects words according to lang. If lang has an embedded
+// language pack, the Generator receives its lists of closed word classes,
+// otherwise the English ones are used. Proper names are shared by all
+// languages.
+func NewGeneratorForLanguage(lang Language, adj, adv, noun, verb []Word, iterLimit int, safe bool, src *rand.Rand) (*Generator, error) {
 	if iterLimit <= 0 {
 		return nil, symbols.ErrBadIterLimit
 	}
@@ -614,19 +1200,57 @@ func NewGeneratorFromWord(adj, adv, noun, verb []Word, iterLimit int, safe bool,
 		}
 	}
 
-	if src == nil {
-		src = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
-	}
-
 	gen := Generator{
-		adj:       adj,
-		adv:       adv,
-		noun:      noun,
-		verb:      verb,
-		caser:     newCaser(),
+		lang:      lang,
+		caser:     newCaser(lang.Tag()),
 		iterLimit: iterLimit,
-		source:    *src,
+	}
+
+	load, ok := extraLists[baseCode(lang)]
+	if !ok {
+		load = extraLists["en"]
+	}
+
+	extra, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	lists := wordLists{adj, adv, noun, verb}
+	copy(lists[WC_PRONOUN:], extra[:])
+
+	if gen.places, err = placeMorphemes(); err != nil {
+		return nil, err
+	}
+
+	gen.lists.Store(&lists)
+
+	if src == nil {
+		gen.Reseed(rand.Uint64(), rand.Uint64())
+	} else {
+		gen.source = *src
 	}
 
 	return &gen, nil
 }
+
+// NewGeneratorFromWord returns Generator created using the provided lists
+// of Word structs and iterLimit. Returns an error if any of the lists is
+// empty. If safe is false, empty / nil checks are omitted.
+// It is assumed that Word structs are created using one of
+// the safe constructors, therefore their validity is not verified. Those
+// constructors do not check word case though - all words should be lower
+// case. Every slice must be sorted A-Z by Word.word field. If safe is true,
+// the function ensures the correct order. iterLimit is an adjustable safety
+// mechanism to prevent inifinite loops during certain transformations. For
+// more information, refer to DEFAULT_ITER_LIMIT in the section 'Constants'.
+// src is the source of random numbers. If src is nil, a new, randomly seeded
+// rand.PCG is created. Its seed can be retrieved with Generator.Seed.
+//
+// Word lists of closed word classes (pronouns, prepositions, conjunctions
+// and interjections) and of proper names are not provided by the user.
+// The Generator receives the default ones, which can be replaced with
+// Generator.ReplaceList. The Language of the Generator is English.
+func NewGeneratorFromWord(adj, adv, noun, verb []Word, iterLimit int, safe bool, src *rand.Rand) (*Generator, error) {
+	return NewGeneratorForLanguage(English{}, adj, adv, noun, verb, iterLimit, safe, src)
+}
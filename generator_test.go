@@ -19,10 +19,14 @@ package neng
 
 import (
 	"errors"
+	"fmt"
+	"math/rand/v2"
 	"runtime/debug"
 	"slices"
+	"strings"
 	"sync"
 	"testing"
+	"testing/fstest"
 
 	"github.com/Zedran/neng/internal/tests"
 	"github.com/Zedran/neng/symbols"
@@ -36,13 +40,83 @@ func TestDefaultGenerator(t *testing.T) {
 		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
 	}
 
-	for i, wl := range [][]Word{gen.adj, gen.adv, gen.noun, gen.verb} {
+	for i, wl := range gen.lists.Load() {
 		if !slices.IsSortedFunc(wl, cmpWord) {
 			t.Fatalf("Failed for list %d - not sorted", i)
 		}
 	}
 }
 
+// Tests whether Generator.AddWord, Generator.RemoveWord and
+// Generator.ReplaceList modify the lists, keep them sorted and reject
+// invalid modifications.
+func TestGenerator_Modify(t *testing.T) {
+	gen, err := NewGenerator([]string{"3big"}, []string{"0nicely"}, []string{"0snowfall"}, []string{"0stash"}, DEFAULT_ITER_LIMIT, false, nil)
+	if err != nil {
+		t.Fatalf("Failed: NewGenerator returned an error: %v", err)
+	}
+
+	for _, line := range []string{"0zebra", "0apple", "5mud", "0apple"} {
+		w, _ := NewWord(line)
+		if err = gen.AddWord(w, WC_NOUN); err != nil {
+			t.Errorf("Failed: AddWord returned an error for '%s': %v", line, err)
+		}
+	}
+
+	if nouns, _ := gen.getList(WC_NOUN); len(nouns) != 4 || !slices.IsSortedFunc(nouns, cmpWord) {
+		t.Errorf("Failed: list has incorrect length or is not sorted: %v", nouns)
+	}
+
+	if _, err = gen.Find("mud", WC_NOUN); err != nil {
+		t.Errorf("Failed: added word not found: %v", err)
+	}
+
+	conflicting, _ := NewWord("1mud,muds")
+	if err = gen.AddWord(conflicting, WC_NOUN); !errors.Is(err, symbols.ErrConflictingWord) {
+		t.Errorf("Failed: conflicting word was not rejected: %v", err)
+	}
+
+	if err = gen.AddWord(conflicting, WordClass(255)); !errors.Is(err, symbols.ErrUndefinedWordClass) {
+		t.Errorf("Failed: undefined WordClass was not rejected: %v", err)
+	}
+
+	if err = gen.RemoveWord("zebra", WC_NOUN); err != nil {
+		t.Errorf("Failed: RemoveWord returned an error: %v", err)
+	}
+
+	if _, err = gen.Find("zebra", WC_NOUN); err == nil {
+		t.Error("Failed: removed word was found")
+	}
+
+	if err = gen.RemoveWord("zebra", WC_NOUN); !errors.Is(err, symbols.ErrNotFound) {
+		t.Errorf("Failed: removal of a missing word was not rejected: %v", err)
+	}
+
+	if err = gen.RemoveWord("stash", WC_VERB); !errors.Is(err, symbols.ErrEmptyLists) {
+		t.Errorf("Failed: removal of the last word was not rejected: %v", err)
+	}
+
+	w1, _ := NewWord("0walk")
+	w2, _ := NewWord("1run,ran,run")
+	replacement := []Word{w1, w2}
+
+	if err = gen.ReplaceList(replacement, WC_VERB); err != nil {
+		t.Errorf("Failed: ReplaceList returned an error: %v", err)
+	}
+
+	if verbs, _ := gen.getList(WC_VERB); len(verbs) != 2 || !slices.IsSortedFunc(verbs, cmpWord) {
+		t.Errorf("Failed: list not replaced or not sorted: %v", verbs)
+	}
+
+	if replacement[0].word != "walk" {
+		t.Error("Failed: ReplaceList modified the received slice")
+	}
+
+	if err = gen.ReplaceList(nil, WC_VERB); !errors.Is(err, symbols.ErrEmptyLists) {
+		t.Errorf("Failed: empty list was not rejected: %v", err)
+	}
+}
+
 // Tests whether Generator.Find correctly returns found words or errors upon failure.
 func TestGenerator_Find(t *testing.T) {
 	type testCase struct {
@@ -94,16 +168,16 @@ func TestGenerator_Iter(t *testing.T) {
 
 	for wc := WC_ADJECTIVE; wc <= WC_VERB; wc++ {
 		if _, err := gen.All(wc); err != nil {
-			t.Fatalf("All failed for WordClass %d: %v", wc, err)
+			t.Fatalf("All failed for WordClass %s: %v", wc, err)
 		}
 
 		list, _ := gen.getList(wc)
 		if n, err := gen.Len(wc); err != nil || n != len(list) {
-			t.Fatalf("Len failed for WordClass %d: %v, len: expected: %d, got: %d", wc, err, len(list), n)
+			t.Fatalf("Len failed for WordClass %s: %v, len: expected: %d, got: %d", wc, err, len(list), n)
 		}
 
 		if _, err := gen.Words(wc); err != nil {
-			t.Fatalf("Words failed for WordClass %d: %v", wc, err)
+			t.Fatalf("Words failed for WordClass %s: %v", wc, err)
 		}
 	}
 
@@ -120,6 +194,57 @@ func TestGenerator_Iter(t *testing.T) {
 	}
 }
 
+// Tests whether a snapshot created with Generator.MarshalBinary restores
+// the source state, so that the same sequence of phrases is generated.
+func TestGenerator_MarshalBinary(t *testing.T) {
+	const pattern = "%tsa %tpn that %m %Npv the %n"
+
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	state, err := gen.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Failed: MarshalBinary returned an error: %v", err)
+	}
+
+	expected := make([]string, 10)
+	for i := range expected {
+		expected[i], _ = gen.Phrase(pattern)
+	}
+
+	replay, err := DefaultGenerator(rand.New(rand.NewPCG(1, 2)))
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	if _, err = replay.MarshalBinary(); !errors.Is(err, symbols.ErrExternalSource) {
+		t.Errorf("Failed: external source was not detected: %v", err)
+	}
+
+	if err = replay.UnmarshalBinary(state); err != nil {
+		t.Fatalf("Failed: UnmarshalBinary returned an error: %v", err)
+	}
+
+	for i, e := range expected {
+		if out, _ := replay.Phrase(pattern); out != e {
+			t.Errorf("Failed for phrase %d: expected '%s', got '%s'", i, e, out)
+		}
+	}
+
+	s1, s2, _ := gen.Seed()
+	if r1, r2, _ := replay.Seed(); r1 != s1 || r2 != s2 {
+		t.Errorf("Failed: seed not restored: expected (%d, %d), got (%d, %d)", s1, s2, r1, r2)
+	}
+
+	for _, bad := range [][]byte{nil, state[:16], state[:len(state)-1]} {
+		if err = replay.UnmarshalBinary(bad); !errors.Is(err, symbols.ErrBadState) {
+			t.Errorf("Failed for %v: ErrBadState not returned: %v", bad, err)
+		}
+	}
+}
+
 // Tests whether Generator.Noun correctly skips:
 //   - uncountable nouns in presence of MOD_PLURAL or MOD_INDEF
 //   - plural-only nouns in presence of MOD_INDEF
@@ -149,7 +274,7 @@ func TestGenerator_Noun(t *testing.T) {
 		t.Errorf("Failed for silent indefinite: plural-only noun was not rejected. Noun returned %s", n)
 	}
 
-	gen.noun = []Word{{ft: FT_UNCOUNTABLE, irr: nil, word: "boldness"}}
+	gen.ReplaceList([]Word{{ft: FT_UNCOUNTABLE, irr: nil, word: "boldness"}}, WC_NOUN)
 
 	if n, err := gen.Noun(MOD_PLURAL); !errors.Is(err, symbols.ErrIterLimit) {
 		t.Errorf("Failed for plural: uncountable noun was not rejected. Noun returned: %s", n)
@@ -167,7 +292,7 @@ func TestGenerator_Noun(t *testing.T) {
 		t.Errorf("Failed for silent indefinite: uncountable noun was not rejected. Noun returned %s", n)
 	}
 
-	gen.noun = []Word{{ft: FT_REGULAR, irr: nil, word: "microscope"}}
+	gen.ReplaceList([]Word{{ft: FT_REGULAR, irr: nil, word: "microscope"}}, WC_NOUN)
 
 	if _, err := gen.Noun(MOD_INDEF); err != nil {
 		t.Errorf("Failed for indefinite article: regular noun was rejected: %v", err)
@@ -185,6 +310,27 @@ func TestGenerator_Phrase(t *testing.T) {
 		t.Fatalf("Failed: NewGenerator returned an error: %v", err)
 	}
 
+	closed := map[WordClass][]string{
+		WC_PRONOUN:      {"0she", "2they"},
+		WC_PREPOSITION:  {"0under"},
+		WC_CONJUNCTION:  {"0and"},
+		WC_INTERJECTION: {"0wow"},
+		WC_GIVEN_NAME:   {"0ada"},
+		WC_SURNAME:      {"0marlowe"},
+	}
+
+	for wc, lines := range closed {
+		list, err := parseLines(lines)
+		if err != nil {
+			t.Fatalf("Failed: parseLines returned an error: %v", err)
+		}
+		if err = gen.ReplaceList(list, wc); err != nil {
+			t.Fatalf("Failed: ReplaceList returned an error: %v", err)
+		}
+	}
+
+	gen.places = &morphemes{stems: []string{"dun"}, suffixes: []string{"mere"}}
+
 	var cases map[string]string
 	if err := tests.ReadData("TestPhrase.json", &cases); err != nil {
 		t.Fatalf("Failed loading test data: %v", err)
@@ -200,13 +346,24 @@ func TestGenerator_Phrase(t *testing.T) {
 	}
 
 	errCases := []string{
-		"",     // Pattern is empty
-		"abc%", // Escape character at pattern termination
-		"%q",   // Unknown command
-		"%cn",  // WordClass-Mod incompatibility
-		"%pv",  // Incorrect use of MOD_PLURAL with verb
-		"%s",   // Transformation specifier ends the pattern
-		"%s%a", // % is not a defined Mod specifier
+		"",            // Pattern is empty
+		"abc%",        // Escape character at pattern termination
+		"%q",          // Unknown command
+		"%cn",         // WordClass-Mod incompatibility
+		"%pv",         // Incorrect use of MOD_PLURAL with verb
+		"%s",          // Transformation specifier ends the pattern
+		"%s%a",        // % is not a defined Mod specifier
+		"%Un",         // No uncountable noun to pair with MOD_MUCH
+		"%p#",         // Mod not compatible with a number
+		"%#{1-",       // Unterminated number range
+		"%#{5-1}",     // Minimum of the range exceeds its maximum
+		"%#{a-b}",     // Malformed number range
+		"%gr",         // Pronouns are not compatible with verb Mods
+		"%On",         // MOD_OBJECTIVE is not compatible with nouns
+		"%pe",         // Prepositions are not compatible with MOD_PLURAL
+		"%#{2-2} %in", // MOD_INDEF is not compatible with a plural noun
+		"%ik",         // Names are not compatible with determiners
+		"%pz",         // Only surnames are compatible with MOD_PLURAL
 	}
 
 	for _, bc := range errCases {
@@ -217,6 +374,50 @@ func TestGenerator_Phrase(t *testing.T) {
 	}
 }
 
+// Tests whether Generators seeded with the same values produce the same
+// phrases and whether Generator.Seed reports the seed correctly.
+func TestGenerator_Seed(t *testing.T) {
+	const pattern = "%tsa %tpn that %m %Npv the %n"
+
+	gen1, err := DefaultGeneratorFromSeed(123, 456)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGeneratorFromSeed returned an error: %v", err)
+	}
+
+	gen2, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	if _, _, err = gen2.Seed(); err != nil {
+		t.Errorf("Failed: Seed returned an error for an internal source: %v", err)
+	}
+
+	gen2.Reseed(123, 456)
+
+	if s1, s2, err := gen2.Seed(); err != nil || s1 != 123 || s2 != 456 {
+		t.Errorf("Failed: expected seed (123, 456), got (%d, %d), error: %v", s1, s2, err)
+	}
+
+	for i := range 10 {
+		p1, _ := gen1.Phrase(pattern)
+		p2, _ := gen2.Phrase(pattern)
+
+		if p1 != p2 {
+			t.Errorf("Failed for phrase %d: '%s' != '%s'", i, p1, p2)
+		}
+	}
+
+	gen3, err := DefaultGenerator(rand.New(rand.NewPCG(123, 456)))
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	if _, _, err = gen3.Seed(); !errors.Is(err, symbols.ErrExternalSource) {
+		t.Errorf("Failed: external source was not detected: %v", err)
+	}
+}
+
 // Tests basic dispatching done by Generator.Transform. More detailed tests
 // are performed for Generator.TransformWord, which receives input from
 // Generator.Transform.
@@ -302,7 +503,7 @@ func TestGenerator_TransformWord(t *testing.T) {
 			if errors.Is(err, symbols.ErrUndefinedWordClass) {
 				// To test whether an undefined WordClass is recognized, error
 				// from Find must be suppressed and word cannot be nil
-				word = gen.noun[0]
+				word = gen.lists.Load()[WC_NOUN][0]
 			} else {
 				t.Fatalf("'%s' (WordClass %d) does not exist in the word database.", c.word, c.wc)
 			}
@@ -389,6 +590,50 @@ func TestGenerator_MT(t *testing.T) {
 	wg.Wait()
 }
 
+// Tests whether modifications of the word lists are safe
+// during concurrent phrase generation.
+func TestGenerator_MT_Modify(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	const T int = 50
+
+	var wg sync.WaitGroup
+	wg.Add(T + 1)
+
+	go func() {
+		defer wg.Done()
+
+		for i := range T {
+			w, _ := NewWordFromParams(fmt.Sprintf("zzz%d", i), FT_REGULAR, nil)
+			if err := gen.AddWord(w, WC_NOUN); err != nil {
+				t.Errorf("AddWord failed: %v", err)
+			}
+
+			if err := gen.RemoveWord("zzz0", WC_NOUN); err != nil && !errors.Is(err, symbols.ErrNotFound) {
+				t.Errorf("RemoveWord failed: %v", err)
+			}
+		}
+	}()
+
+	for i := 0; i < T; i++ {
+		go func() {
+			defer wg.Done()
+
+			if phrase, err := gen.Phrase("%tsa %fpn that %lm %uNpv %in"); err != nil {
+				t.Errorf("Thread %d encountered an error: '%v', phrase: '%s'", i, err, phrase)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if nouns, _ := gen.getList(WC_NOUN); !slices.IsSortedFunc(nouns, cmpWord) {
+		t.Error("Failed: list not sorted after concurrent modifications")
+	}
+}
+
 // Tests NewGenerator. Fails if it does not return an error upon receiving
 // an empty list, nil or invalid iterLimit value. Malformed or empty slice
 // elements trigger an error as well. Takes safe value into account during
@@ -446,6 +691,36 @@ func TestNewGenerator(t *testing.T) {
 	}
 }
 
+// Tests whether NewGeneratorFromFS reads word lists from the file system
+// and reports missing files and malformed lines along with the file name.
+func TestNewGeneratorFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"adj":      {Data: []byte("# comment\r\n3big\r\n")},
+		"adv":      {Data: []byte("0nicely\n")},
+		"noun":     {Data: []byte("0snowfall\n\n")},
+		"verb":     {Data: []byte("0stash")},
+		"bad/verb": {Data: []byte("0stash\n1walk\n")},
+	}
+
+	gen, err := NewGeneratorFromFS(fsys, "adj", "adv", "noun", "verb", DEFAULT_ITER_LIMIT, true, nil)
+	if err != nil {
+		t.Fatalf("Failed: NewGeneratorFromFS returned an error: %v", err)
+	}
+
+	if phrase, _ := gen.Phrase("%ca %n %Nv %m"); phrase != "bigger snowfall stashes nicely" {
+		t.Errorf("Failed: unexpected phrase '%s'", phrase)
+	}
+
+	if _, err = NewGeneratorFromFS(fsys, "adj", "adv", "noun", "missing", DEFAULT_ITER_LIMIT, true, nil); err == nil {
+		t.Error("Failed: missing file did not trigger an error")
+	}
+
+	_, err = NewGeneratorFromFS(fsys, "adj", "adv", "noun", "bad/verb", DEFAULT_ITER_LIMIT, true, nil)
+	if !errors.Is(err, symbols.ErrBadWordList) || !strings.Contains(err.Error(), "bad/verb: line 2") {
+		t.Errorf("Failed: malformed line not reported correctly: %v", err)
+	}
+}
+
 // Tests NewGeneratorFromWord ensuring that all checks correctly trigger errors.
 func TestNewGeneratorFromWord(t *testing.T) {
 	type testCase struct {
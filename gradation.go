@@ -21,7 +21,11 @@ import "strings"
 
 // comparative returns a comparative form of an adjective or an adverb
 // (good -> better).
-func comparative(word Word) string {
+func comparative(word Word, opts InflectOptions) string {
+	if form, ok := opts.override(MOD_COMPARATIVE); ok {
+		return form
+	}
+
 	switch word.ft {
 	case FT_IRREGULAR:
 		return (*word.irr)[0]
@@ -54,7 +58,11 @@ func sufGrad(a, suf string) string {
 
 // superlative returns a superlative form of an adjective or an adverb
 // (good -> best).
-func superlative(word Word) string {
+func superlative(word Word, opts InflectOptions) string {
+	if form, ok := opts.override(MOD_SUPERLATIVE); ok {
+		return form
+	}
+
 	switch word.ft {
 	case FT_IRREGULAR:
 		return (*word.irr)[1]
@@ -54,7 +54,7 @@ func TestComparative(t *testing.T) {
 			}
 		}
 
-		output := comparative(word)
+		output := comparative(word, InflectOptions{})
 
 		if output != c.Expected {
 			t.Errorf("Failed for '%s': expected '%s', got '%s'", c.Input, c.Expected, output)
@@ -109,7 +109,7 @@ func TestSuperlative(t *testing.T) {
 			}
 		}
 
-		output := superlative(word)
+		output := superlative(word, InflectOptions{})
 
 		if output != c.Expected {
 			t.Errorf("Failed for '%s': expected '%s', got '%s'", c.Input, c.Expected, output)
@@ -17,41 +17,135 @@
 
 package neng
 
-import "strings"
+import (
+	"strings"
+	"unicode"
+)
+
+// articleExceptions lists the beginnings of words whose indefinite article
+// does not follow from the first letter. The values are the articles.
+// The longest matching entry wins, so that 'unin' (uninformed) overrides
+// 'uni' (uniform). Entries are matched against the whole phrase, so that
+// 'hors d' covers 'hors d'oeuvre', but not 'horse'.
+var articleExceptions = map[string]string{
+	// Silent 'h'
+	"heir":   "an",
+	"honest": "an",
+	"honor":  "an",
+	"honour": "an",
+	"hors d": "an",
+	"hour":   "an",
+	"houri":  "a",
+
+	// 'u' and 'eu' sounding like 'you'
+	"eu":         "a",
+	"ewe":        "a",
+	"ub":         "a",
+	"uk":         "a",
+	"uni":        "a",
+	"unid":       "an",
+	"unim":       "an",
+	"unimodal":   "a",
+	"unin":       "an",
+	"uninominal": "a",
+	"unir":       "an",
+	"ura":        "a",
+	"ure":        "a",
+	"uri":        "a",
+	"us":         "a",
+	"ush":        "an",
+	"ut":         "a",
+	"utt":        "an",
+
+	// 'o' sounding like 'w'
+	"once":  "a",
+	"one":   "a",
+	"onei":  "an",
+	"oner":  "an",
+	"ouija": "a",
+}
+
+// vowelLetters lists letters whose names begin with a vowel sound,
+// which decides the article of initialisms (an FBI agent, a UN envoy).
+const vowelLetters = "aefhilmnorsx"
 
 // indefinite returns the word prefixed with an indefinite article.
-func indefinite(word string) string {
-	switch word[0] {
-	case 'a', 'i', 'e', 'o':
-		return "an " + word
-	case 'u':
-		return indefiniteU(word)
-	}
-	return "a " + word
+// The article is chosen by the pronunciation of the first word of w,
+// so that a comparative is preceded by 'a' (a more honest).
+func indefinite(w string) string {
+	return article(w) + " " + w
 }
 
-// indefiniteU prefixes the word beginning with 'u-' with an indefinite article.
-func indefiniteU(word string) string {
-	if len(word) == 1 {
-		return "a " + word
+// article returns the indefinite article suitable for w.
+func article(w string) string {
+	first, _, _ := strings.Cut(w, " ")
+	token, _, _ := strings.Cut(first, "-")
+
+	if len(token) == 0 {
+		return "a"
 	}
 
-	switch word[1] {
-	case 'n':
-		if strings.HasPrefix(word, "uni") {
-			if len(word) == 3 || !strings.ContainsRune("nmdr", rune(word[3])) || word == "unimodal" || word == "uninominal" {
-				// unin-, unim-, unid-, unir- (for a single 'unironed')
-				return "a " + word
-			}
+	if isInitialism(token) {
+		if strings.ContainsRune(vowelLetters, unicode.ToLower(rune(token[0]))) {
+			return "an"
+		}
+		return "a"
+	}
+
+	if token[0] >= '0' && token[0] <= '9' {
+		return articleNumber(token)
+	}
+
+	// Entries may extend past the first word (hors d'oeuvre)
+	lower := strings.ToLower(w)
+
+	for i := len(lower); i > 0; i-- {
+		if a, ok := articleExceptions[lower[:i]]; ok {
+			return a
 		}
-	case 's':
-		if !strings.HasPrefix(word, "ush") {
-			return "a " + word
+	}
+
+	switch lower[0] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return "an"
+	}
+	return "a"
+}
+
+// articleNumber returns the indefinite article suitable for a number
+// written in digits. Only the numbers spoken with 'eight', 'eleven'
+// or 'eighteen' at the beginning take 'an' (an 8, an 11, an 18,000).
+func articleNumber(token string) string {
+	integer, _, _ := strings.Cut(token, ".")
+
+	// Strip thousands separators
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
 		}
-	case 't':
-		if !strings.HasPrefix(word, "utt") {
-			return "a " + word
+		return -1
+	}, integer)
+
+	switch true {
+	case digits[0] == '8':
+		return "an"
+	case len(digits)%3 == 2 && (strings.HasPrefix(digits, "11") || strings.HasPrefix(digits, "18")):
+		return "an"
+	}
+	return "a"
+}
+
+// isInitialism returns true if token is a single letter or consists
+// of capital letters only, in which case it is spelled letter by letter.
+func isInitialism(token string) bool {
+	if len(token) == 1 {
+		return unicode.IsLetter(rune(token[0]))
+	}
+
+	for _, r := range token {
+		if r < 'A' || r > 'Z' {
+			return false
 		}
 	}
-	return "an " + word
+	return len(token) > 0
 }
@@ -0,0 +1,176 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package phon contains the phonological heuristics shared by neng
+// and its scripts, which cannot import neng.
+package phon
+
+import "strings"
+
+// vowels lists the letters that form the 'v' elements of a sequence.
+const vowels = "aeiou"
+
+// Sequence returns a vowel-consonant sequence of s ('word' == 'cvcc').
+func Sequence(s string) string {
+	var seq strings.Builder
+
+	for i, c := range s {
+		if i == len(s)-1 && c == 'y' && i > 0 && !strings.ContainsRune(vowels, rune(s[i-1])) {
+			// A special case of final 'y' following a consonant
+			seq.WriteByte('v')
+		} else if strings.ContainsRune(vowels, c) {
+			seq.WriteByte('v')
+		} else {
+			seq.WriteByte('c')
+		}
+	}
+
+	return seq.String()
+}
+
+// Syllables returns a number of syllables in s given the consonant-vowel
+// sequence. General accuracy of this function is not very high, especially
+// for borrowed words (cafe). It targets specific groups of verbs.
+func Syllables(s, seq string) int {
+	if len(s) == 0 {
+		return 0
+	}
+
+	var (
+		prevVowel bool
+		count     int
+	)
+
+	for _, sc := range seq {
+		switch sc {
+		case 'v':
+			if !prevVowel {
+				// Diphthongs and long vowels are part of one syllable
+				prevVowel = true
+				count++
+			}
+		default:
+			prevVowel = false
+		}
+	}
+
+	if count > 1 {
+		for _, suf := range []string{"eat", "eate", "iate", "uate"} {
+			if strings.HasSuffix(s, suf) {
+				// When apparent diphthongs are in fact individual vowels
+				// and belong to separate syllables
+				count++
+				break
+			}
+		}
+	}
+
+	if strings.HasSuffix(s, "e") && strings.HasSuffix(seq, "cv") {
+		// A final 'e' preceded by a consonant (silent 'e')
+		// does not constitute the next syllable
+		count--
+	}
+
+	if count == 0 {
+		return 1
+	}
+
+	return count
+}
+
+// Score estimates how easy s is to pronounce and read on a scale from 1
+// (hard) to 100 (easy). The score decreases with every feature that makes
+// a word harder to say:
+//
+//   - long consonant clusters (strengths) and vowel runs (aalii)
+//   - words without vowels (nth)
+//   - length exceeding 10 letters or 4 syllables
+//   - characters other than letters, which mark multi-word collocations,
+//     hyphenated compounds and abbreviations
+func Score(s string) int {
+	s = strings.ToLower(s)
+
+	score := 100
+
+	var letters strings.Builder
+	for _, c := range s {
+		if c >= 'a' && c <= 'z' {
+			letters.WriteRune(c)
+		} else {
+			score -= 15
+		}
+	}
+
+	word := letters.String()
+	if len(word) == 0 {
+		return 1
+	}
+
+	seq := []byte(Sequence(word))
+
+	// 'y' is a vowel when it does not precede one (rhythm, psychology)
+	for i := 1; i < len(word); i++ {
+		if word[i] == 'y' && (i == len(word)-1 || !strings.ContainsRune(vowels, rune(word[i+1]))) {
+			seq[i] = 'v'
+		}
+	}
+
+	if !strings.Contains(string(seq), "v") {
+		score -= 40
+	}
+
+	for i := 0; i < len(seq); {
+		j := i
+		for j < len(seq) && seq[j] == seq[i] {
+			j++
+		}
+
+		switch run := j - i; true {
+		case seq[i] == 'c' && run > 2:
+			score -= 12 * (run - 2)
+		case seq[i] == 'c' && run == 2 && (i == 0 || j == len(seq)) && !commonCluster(word[i:j]):
+			// Unusual clusters at word boundaries (pteron, tsk)
+			score -= 8
+		case seq[i] == 'v' && run > 2:
+			score -= 10 * (run - 2)
+		case seq[i] == 'v' && run == 2 && word[i] == word[i+1] && word[i] != 'e' && word[i] != 'o':
+			// Doubled vowels other than 'ee' and 'oo' (aalii)
+			score -= 10
+		}
+
+		i = j
+	}
+
+	if len(word) > 10 {
+		score -= 4 * (len(word) - 10)
+	}
+
+	if syl := Syllables(word, string(seq)); syl > 4 {
+		score -= 8 * (syl - 4)
+	}
+
+	return max(score, 1)
+}
+
+// commonCluster returns true if a two-consonant cluster c is common
+// at the beginning or the end of English words.
+func commonCluster(c string) bool {
+	return strings.Contains(commonClusters, " "+c+" ")
+}
+
+// commonClusters lists two-consonant clusters common at word boundaries.
+const commonClusters = " bl br ch ck cl cr ct dr dg ff fl fr ft gh gl gn gr kn ld lf lk ll lm lp ls lt mb mp nc nd ng nk ns nt ph pl pr ps pt rb rc rd rf rg rk rl rm rn rp rs rt sc sh sk sl sm sn sp ss st sw th tr ts tw wh wl wn wr xt "
@@ -18,21 +18,31 @@
 // Script embed builds the embedded files from resource files
 // created with [github.com/Zedran/neng/internal/scripts/res].
 //
-//	go run internal/scripts/embed/embed.go
+//	go run internal/scripts/embed/embed.go [-filter-score N]
+//
+// The script computes the pronounceability score of every embedded word
+// and stores the scores of each language pack in its 'score' file,
+// from which Generator reads them instead of computing them at run time.
+// The -filter-score flag omits the words scoring below N (1-100) from
+// the embedded files. The lists of place name stems and suffixes
+// are neither scored nor filtered.
 //
 // Run in package's root directory.
 package main
 
 import (
 	"errors"
+	"flag"
 	"fmt"
 	"log"
+	"maps"
 	"os"
 	"path/filepath"
 	"slices"
 	"strings"
 	"sync"
 
+	"github.com/Zedran/neng/internal/phon"
 	"github.com/Zedran/neng/internal/scripts/common"
 )
 
@@ -61,9 +71,55 @@ func cmpIrr(irr, b string) int {
 	return strings.Compare(irr[:i], b)
 }
 
+// scoreSet collects the pronounceability scores of the embedded words,
+// grouped by the directories of their lists.
+type scoreSet struct {
+	mu     sync.Mutex
+	scores map[string]map[string]int
+}
+
+// add records the scores of words from the list stored in EMBED_DIR/fname.
+func (s *scoreSet) add(fname string, words map[string]int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dir := filepath.Dir(fname)
+	if s.scores[dir] == nil {
+		s.scores[dir] = make(map[string]int)
+	}
+	maps.Copy(s.scores[dir], words)
+}
+
+// write stores the collected scores in the 'score' file of every directory.
+// Each line holds a score and a word, separated by a comma.
+func (s *scoreSet) write() error {
+	for dir, scores := range s.scores {
+		lines := make([]string, 0, len(scores))
+		for w, score := range scores {
+			lines = append(lines, fmt.Sprintf("%d,%s", score, w))
+		}
+		slices.SortFunc(lines, func(a, b string) int {
+			_, wa, _ := strings.Cut(a, ",")
+			_, wb, _ := strings.Cut(b, ",")
+			return strings.Compare(wa, wb)
+		})
+
+		csum, err := common.WriteFile(filepath.Join(EMBED_DIR, dir, "score"), lines, false)
+		if err != nil {
+			return fmt.Errorf("%s: %w", filepath.Join(dir, "score"), err)
+		}
+
+		fmt.Println(csum)
+	}
+
+	return nil
+}
+
 // compile builds the main word list and any number of supplementary lists into
-// the embedded file stored in EMBED_DIR/mainFname.
-func compile(wg *sync.WaitGroup, chErr chan error, mainFname string, supFnames ...string) {
+// the embedded file stored in EMBED_DIR/mainFname. Words scoring below
+// minScore are omitted. The scores of the embedded words are added to ss,
+// unless it is nil.
+func compile(wg *sync.WaitGroup, chErr chan error, ss *scoreSet, minScore int, mainFname string, supFnames ...string) {
 	const ERR_FMT = "%s: %w"
 
 	defer wg.Done()
@@ -80,9 +136,22 @@ func compile(wg *sync.WaitGroup, chErr chan error, mainFname string, supFnames .
 		return
 	}
 
-	embed := make([]string, len(main))
-	for i, w := range main {
-		embed[i] = processLine(w, supplementary)
+	var (
+		embed  = make([]string, 0, len(main))
+		scores = make(map[string]int, len(main))
+	)
+
+	for _, w := range main {
+		score := phon.Score(w)
+		if minScore > 0 && score < minScore {
+			continue
+		}
+		embed = append(embed, processLine(w, supplementary))
+		scores[w] = score
+	}
+
+	if dropped := len(main) - len(embed); dropped > 0 {
+		fmt.Printf("%s: omitted %d word(s) scoring below %d\n", mainFname, dropped, minScore)
 	}
 
 	csum, err := common.WriteFile(filepath.Join(EMBED_DIR, mainFname), embed, false)
@@ -91,6 +160,10 @@ func compile(wg *sync.WaitGroup, chErr chan error, mainFname string, supFnames .
 		return
 	}
 
+	if ss != nil {
+		ss.add(mainFname, scores)
+	}
+
 	fmt.Println(csum)
 }
 
@@ -159,28 +232,57 @@ func readSupWLs(fnames ...string) (map[FormType][]string, error) {
 func main() {
 	log.SetFlags(0)
 
-	if err := os.Mkdir(EMBED_DIR, 0755); err != nil && !os.IsExist(err) {
+	minScore := flag.Int("filter-score", 0, "omit words whose pronounceability score is lower than the value")
+	flag.Parse()
+
+	if *minScore < 0 || *minScore > 100 {
+		log.Fatalf("Invalid minimum score %d: expected 0-100", *minScore)
+	}
+
+	if err := os.MkdirAll(filepath.Join(EMBED_DIR, "eo"), 0755); err != nil {
 		log.Fatalf("Could not create embed directory: %v", err)
 	}
 
 	var (
-		chErr = make(chan error, 4)
-
 		err error
 		wg  sync.WaitGroup
 
 		res = map[string][]string{
-			"adj":  {"adj.irr", "adj.ncmp", "adj.suf"},
-			"adv":  {"adv.irr", "adv.ncmp", "adv.suf"},
-			"noun": {"noun.irr", "noun.plo", "noun.unc"},
-			"verb": {"verb.irr"},
+			"adj":       {"adj.irr", "adj.ncmp", "adj.suf"},
+			"adv":       {"adv.irr", "adv.ncmp", "adv.suf"},
+			"conj":      nil,
+			"eo/adj":    nil,
+			"eo/adv":    {"eo/adv.ncmp"},
+			"eo/conj":   nil,
+			"eo/intj":   nil,
+			"eo/noun":   {"eo/noun.unc"},
+			"eo/prep":   nil,
+			"eo/pron":   {"eo/pron.plo"},
+			"eo/verb":   nil,
+			"given":     nil,
+			"intj":      nil,
+			"noun":      {"noun.irr", "noun.plo", "noun.unc"},
+			"place":     nil,
+			"place.suf": nil,
+			"prep":      nil,
+			"pron":      {"pron.plo"},
+			"surname":   nil,
+			"verb":      {"verb.irr"},
 		}
+
+		chErr = make(chan error, len(res))
+
+		scores = scoreSet{scores: make(map[string]map[string]int)}
 	)
 
-	wg.Add(4)
+	wg.Add(len(res))
 
 	for main, sup := range res {
-		go compile(&wg, chErr, main, sup...)
+		if strings.HasPrefix(main, "place") {
+			go compile(&wg, chErr, nil, 0, main, sup...)
+		} else {
+			go compile(&wg, chErr, &scores, *minScore, main, sup...)
+		}
 	}
 
 	wg.Wait()
@@ -190,6 +292,10 @@ func main() {
 		err = errors.Join(err, e)
 	}
 
+	if err == nil {
+		err = scores.write()
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,146 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import "golang.org/x/text/language"
+
+// Language implements the grammar of the words returned by a Generator:
+// inflection, determiners, numbers and casing. Generator.TransformWord
+// validates the received Mods with WordClass.CompatibleWith and the word's
+// FormType before passing them to the Language, so that implementations
+// can assume the Mods are compatible with each other.
+//
+// The package provides English, the default Language of every Generator,
+// and Esperanto. A Generator speaking a Language is created with
+// NewGeneratorForLanguage or DefaultGeneratorForLanguage.
+type Language interface {
+	// Determine precedes w with the determiner requested in mods
+	// (MOD_INDEF, MOD_DEF, MOD_EVERY, MOD_SOME, MOD_MANY, MOD_MUCH).
+	// If no determiner is requested, w is returned unchanged.
+	Determine(w string, mods Mod) string
+
+	// Inflect transforms word, a member of wc, according to the grammar
+	// Mods enabled in mods and returns it. Determiners and case Mods
+	// are applied by the Generator afterwards. opts holds the opaque
+	// settings of English inflection, which other languages ignore.
+	//
+	// Adjectives preceding a noun that agrees with a plural number
+	// in Generator.Phrase receive MOD_PLURAL, which is otherwise
+	// incompatible with them. Languages without adjective agreement
+	// ignore it.
+	Inflect(word Word, wc WordClass, mods Mod, opts InflectOptions) string
+
+	// Number formats n according to MOD_SPELLED and MOD_ORDINAL.
+	Number(n int, mods Mod) string
+
+	// Tag returns the tag of the language. It selects the casing rules
+	// and the embedded word lists used by DefaultGeneratorForLanguage.
+	Tag() language.Tag
+}
+
+// English is the default Language of the Generator.
+type English struct{}
+
+// Determine implements Language. The indefinite article is selected
+// based on the pronunciation of w (a cat, an hour).
+func (English) Determine(w string, mods Mod) string {
+	return determiner(w, mods)
+}
+
+// Inflect implements Language. Adjectives, adverbs, nouns and verbs
+// are respelled according to the Generator's Spelling before
+// the inflection.
+func (English) Inflect(word Word, wc WordClass, mods Mod, opts InflectOptions) string {
+	if wc <= WC_VERB {
+		word = opts.spelling.spell(word)
+	}
+
+	switch wc {
+	case WC_ADJECTIVE, WC_ADVERB:
+		if mods.Enabled(MOD_COMPARATIVE) {
+			return comparative(word, opts)
+		} else if mods.Enabled(MOD_SUPERLATIVE) {
+			return superlative(word, opts)
+		}
+	case WC_NOUN, WC_GIVEN_NAME, WC_SURNAME, WC_PLACE:
+		if mods.Enabled(MOD_PLURAL | MOD_MANY) {
+			if wc == WC_NOUN && word.ft == FT_REGULAR && isMultiWord(word.word) {
+				return inflectMultiWord(word, wc, mods, opts)
+			}
+
+			w := plural(word, opts)
+			if mods.Enabled(MOD_POSSESSIVE) {
+				w = possessive(w, true)
+			}
+			return w
+		} else if mods.Enabled(MOD_POSSESSIVE) {
+			return possessive(word.word, false)
+		}
+	case WC_VERB:
+		if mods.Enabled(mod_compound|MOD_PAST_SIMPLE|MOD_PAST_PARTICIPLE|MOD_PRESENT_SIMPLE|MOD_GERUND) && word.ft == FT_REGULAR && isMultiWord(word.word) {
+			return inflectMultiWord(word, wc, mods, opts)
+		}
+
+		if mods.Enabled(mod_compound) {
+			return compound(word, mods, opts)
+		} else if mods.Enabled(MOD_PAST_SIMPLE) {
+			return pastSimple(word, mods.Enabled(MOD_PLURAL), opts)
+		} else if mods.Enabled(MOD_PAST_PARTICIPLE) {
+			return pastParticiple(word, opts)
+		} else if mods.Enabled(MOD_PRESENT_SIMPLE) {
+			return presentSimple(word.word, mods.Enabled(MOD_PLURAL), opts)
+		} else if mods.Enabled(MOD_GERUND) {
+			return gerund(word.word, opts)
+		}
+	case WC_PRONOUN:
+		if mods.Enabled(MOD_OBJECTIVE) {
+			if form, ok := opts.override(MOD_OBJECTIVE); ok {
+				return form
+			}
+			return objective(word.word)
+		}
+	}
+
+	// If no mods other than case transformation
+	// or determiners are requested, the word remains unchanged
+	return word.word
+}
+
+// Number implements Language. Spelled numbers follow American style
+// (one hundred one).
+func (English) Number(n int, mods Mod) string {
+	return formatNumber(n, mods)
+}
+
+// Tag implements Language.
+func (English) Tag() language.Tag {
+	return language.English
+}
+
+// embeddedDirs maps the base language codes of the embedded language packs
+// to the directories holding their word lists.
+var embeddedDirs = map[string]string{
+	"en": "embed",
+	"eo": "embed/eo",
+}
+
+// baseCode returns the base language code of lang, e.g. "en".
+func baseCode(lang Language) string {
+	base, _ := lang.Tag().Base()
+	return base.String()
+}
@@ -0,0 +1,113 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Zedran/neng/internal/tests"
+	"github.com/Zedran/neng/symbols"
+	"golang.org/x/text/language"
+)
+
+// klingon is a Language without an embedded language pack.
+type klingon struct{ English }
+
+func (klingon) Tag() language.Tag { return language.MustParse("tlh") }
+
+// Tests DefaultGeneratorForLanguage. Fails if the embedded language pack
+// is not loaded or a Language without the pack is accepted.
+func TestDefaultGeneratorForLanguage(t *testing.T) {
+	gen, err := DefaultGeneratorForLanguage(Esperanto{}, nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGeneratorForLanguage returned an error: %v", err)
+	}
+
+	for wc, word := range map[WordClass]string{WC_NOUN: "hundo", WC_VERB: "kuri", WC_PRONOUN: "ŝi", WC_PREPOSITION: "sur"} {
+		if _, err := gen.Find(word, wc); err != nil {
+			t.Errorf("Failed: '%s' not found in %s: %v", word, wc, err)
+		}
+	}
+
+	if _, err := DefaultGeneratorForLanguage(klingon{}, nil); !errors.Is(err, symbols.ErrUnsupportedLanguage) {
+		t.Errorf("Failed: expected ErrUnsupportedLanguage, got %v", err)
+	}
+}
+
+// Tests whether Generator.Phrase follows the rules of the Generator's
+// Language.
+func TestGenerator_PhraseEsperanto(t *testing.T) {
+	lines := map[WordClass][]string{
+		WC_ADJECTIVE:    {"0bela"},
+		WC_ADVERB:       {"0rapide"},
+		WC_NOUN:         {"0hundo"},
+		WC_VERB:         {"0kuri"},
+		WC_PRONOUN:      {"0ŝi", "2ili"},
+		WC_PREPOSITION:  {"0sur"},
+		WC_CONJUNCTION:  {"0kaj"},
+		WC_INTERJECTION: {"0ho"},
+	}
+
+	lists := make(map[WordClass][]Word, len(lines))
+	for wc, l := range lines {
+		list, err := parseLines(l)
+		if err != nil {
+			t.Fatalf("Failed: parseLines returned an error: %v", err)
+		}
+		lists[wc] = list
+	}
+
+	gen, err := NewGeneratorForLanguage(Esperanto{}, lists[WC_ADJECTIVE], lists[WC_ADVERB], lists[WC_NOUN], lists[WC_VERB], DEFAULT_ITER_LIMIT, true, nil)
+	if err != nil {
+		t.Fatalf("Failed: NewGeneratorForLanguage returned an error: %v", err)
+	}
+
+	for wc := WC_PRONOUN; wc <= WC_INTERJECTION; wc++ {
+		if err := gen.ReplaceList(lists[wc], wc); err != nil {
+			t.Fatalf("Failed: ReplaceList returned an error: %v", err)
+		}
+	}
+
+	var cases map[string]string
+	if err := tests.ReadData("TestPhraseEsperanto.json", &cases); err != nil {
+		t.Fatalf("Failed loading test data: %v", err)
+	}
+
+	for input, expected := range cases {
+		output, err := gen.Phrase(input)
+		if err != nil {
+			t.Errorf("Failed for case '%s': error returned: %v", input, err)
+		} else if output != expected {
+			t.Errorf("Failed for case '%s': got '%s', expected '%s'", input, output, expected)
+		}
+	}
+}
+
+// Tests whether a Generator speaking a Language without an embedded
+// language pack receives the English lists of closed word classes.
+func TestNewGeneratorForLanguage(t *testing.T) {
+	gen, err := NewGeneratorForLanguage(klingon{}, []Word{{word: "big"}}, []Word{{word: "nicely"}}, []Word{{word: "snowfall"}}, []Word{{word: "stash"}}, DEFAULT_ITER_LIMIT, false, nil)
+	if err != nil {
+		t.Fatalf("Failed: NewGeneratorForLanguage returned an error: %v", err)
+	}
+
+	if _, err := gen.Find("she", WC_PRONOUN); err != nil {
+		t.Errorf("Failed: English pronouns not loaded: %v", err)
+	}
+}
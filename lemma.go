@@ -0,0 +1,150 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Lemma is a candidate base form of an inflected word, returned
+// by Generator.Lemmatize. Transforming Word of WordClass WC according
+// to Mod yields the inflected word.
+type Lemma struct {
+	// Base form of the inflected word
+	Word Word
+
+	// WordClass of the Word
+	WC WordClass
+
+	// Transformation that turns the Word into the inflected word.
+	// MOD_NONE if the queried word is a base form itself.
+	Mod Mod
+}
+
+// lemmaIndex maps inflected forms to their Lemmas.
+type lemmaIndex map[string][]Lemma
+
+// lazyIndex holds an index built from a specific snapshot of word lists.
+// The index is rebuilt on first access after the snapshot is replaced.
+type lazyIndex[T any] struct {
+	// Snapshot from which the index was built
+	src *wordLists
+
+	// The index itself
+	idx T
+
+	// Mutex for src and idx
+	mu sync.Mutex
+}
+
+// get returns the index corresponding to lists, building it with build
+// if the stored index originates from another snapshot.
+func (li *lazyIndex[T]) get(lists *wordLists, build func(*wordLists) T) T {
+	li.mu.Lock()
+	defer li.mu.Unlock()
+
+	if li.src != lists {
+		li.idx = build(lists)
+		li.src = lists
+	}
+
+	return li.idx
+}
+
+// inflectionMods returns the Mod values that yield distinct inflected forms
+// of words belonging to wc. WC_PLACE, which has no word list,
+// and undefined WordClass values yield nil.
+func inflectionMods(wc WordClass) []Mod {
+	switch wc {
+	case WC_ADJECTIVE, WC_ADVERB:
+		return []Mod{MOD_NONE, MOD_COMPARATIVE, MOD_SUPERLATIVE}
+	case WC_NOUN:
+		return []Mod{MOD_NONE, MOD_PLURAL}
+	case WC_VERB:
+		return []Mod{
+			MOD_NONE, MOD_PAST_SIMPLE, MOD_PAST_SIMPLE | MOD_PLURAL, MOD_PAST_PARTICIPLE,
+			MOD_PRESENT_SIMPLE, MOD_PRESENT_SIMPLE | MOD_PLURAL, MOD_GERUND,
+		}
+	case WC_PRONOUN:
+		return []Mod{MOD_NONE, MOD_OBJECTIVE}
+	case WC_PREPOSITION, WC_CONJUNCTION, WC_INTERJECTION, WC_GIVEN_NAME:
+		return []Mod{MOD_NONE}
+	case WC_SURNAME:
+		return []Mod{MOD_NONE, MOD_PLURAL}
+	default:
+		return nil
+	}
+}
+
+// Lemmatize returns every Lemma the word can be derived from, e.g. "geese"
+// yields goose (WC_NOUN, MOD_PLURAL), "made" yields make (WC_VERB,
+// MOD_PAST_SIMPLE) and make (WC_VERB, MOD_PAST_PARTICIPLE). Base forms
+// are reported with MOD_NONE. Lemmas are ordered by WordClass and Mod.
+//
+// The reverse index is built from the Generator's word lists on the first
+// call and rebuilt after the lists are modified. It uses the same rules
+// as Generator.TransformWord. The word is converted to lower case and
+// stripped of surrounding whitespace before the search.
+//
+// Returns symbols.ErrNotFound if the word cannot be derived from any word
+// in the Generator's lists.
+func (gen *Generator) Lemmatize(word string) ([]Lemma, error) {
+	idx := gen.lemmas.get(gen.lists.Load(), gen.buildLemmaIndex)
+
+	lemmas, ok := idx[strings.ToLower(strings.TrimSpace(word))]
+	if !ok {
+		return nil, symbols.ErrNotFound
+	}
+
+	return slices.Clone(lemmas), nil
+}
+
+// buildLemmaIndex transforms every word in lists with every Mod returned
+// by inflectionMods and maps the results to their Lemmas. Plural forms
+// identical to another form of the same Word (e.g. plural Present Simple
+// of most verbs) are omitted. Forms are indexed in lower case, because
+// proper names are transformed to Title Case by default.
+func (gen *Generator) buildLemmaIndex(lists *wordLists) lemmaIndex {
+	idx := make(lemmaIndex)
+
+	for wc, list := range lists {
+		mods := inflectionMods(WordClass(wc))
+		forms := make([]string, 0, len(mods))
+
+		for _, w := range list {
+			forms = forms[:0]
+
+			for _, m := range mods {
+				f, err := gen.TransformWord(w, WordClass(wc), m)
+				if err != nil || slices.Contains(forms, f) && m&MOD_PLURAL != 0 {
+					continue
+				}
+
+				forms = append(forms, f)
+				f = strings.ToLower(f)
+				idx[f] = append(idx[f], Lemma{Word: w, WC: WordClass(wc), Mod: m})
+			}
+		}
+	}
+
+	return idx
+}
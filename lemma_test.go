@@ -0,0 +1,82 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Zedran/neng/internal/tests"
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests whether Generator.Lemmatize finds every expected Lemma
+// of inflected words.
+func TestGenerator_Lemmatize(t *testing.T) {
+	type lemma struct {
+		Word string    `json:"word"`
+		WC   WordClass `json:"word_class"`
+		Mod  Mod       `json:"mod"`
+	}
+
+	var cases map[string][]lemma
+	if err := tests.ReadData("TestLemmatize.json", &cases); err != nil {
+		t.Fatalf("Failed loading test data: %v", err)
+	}
+
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	for input, expected := range cases {
+		out, err := gen.Lemmatize(input)
+		if err != nil {
+			t.Errorf("Failed for '%s': error returned: %v", input, err)
+			continue
+		}
+
+		for _, e := range expected {
+			if !slices.ContainsFunc(out, func(l Lemma) bool {
+				return l.Word.word == e.Word && l.WC == e.WC && l.Mod == e.Mod
+			}) {
+				t.Errorf("Failed for '%s': lemma %v not found in %v", input, e, out)
+			}
+		}
+	}
+
+	if l, err := gen.Lemmatize(" Geese "); err != nil || len(l) == 0 {
+		t.Errorf("Failed: input was not normalized: %v", err)
+	}
+
+	if l, err := gen.Lemmatize("goosed"); err != nil || !slices.ContainsFunc(l, func(l Lemma) bool { return l.WC == WC_VERB }) {
+		t.Errorf("Failed: verb 'goose' not found: %v", err)
+	}
+
+	if l, err := gen.Lemmatize("qwerty"); !errors.Is(err, symbols.ErrNotFound) {
+		t.Errorf("Failed: expected ErrNotFound, got %v, %v", l, err)
+	}
+
+	w, _ := NewWord("0qwerty")
+	gen.AddWord(w, WC_NOUN)
+
+	if l, err := gen.Lemmatize("qwerties"); err != nil || len(l) != 1 || l[0].Mod != MOD_PLURAL {
+		t.Errorf("Failed: index not rebuilt after modification: %v, %v", l, err)
+	}
+}
@@ -0,0 +1,67 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"strings"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Entry is a word found in one of the Generator's lists, along with
+// the WordClass of the list. It is returned by Generator.Lookup and can be
+// passed to Generator.TransformEntry.
+type Entry struct {
+	// Word from the list
+	Word Word
+
+	// WordClass of the list
+	WC WordClass
+}
+
+// Lookup searches every word list of the Generator for word and returns
+// an Entry for each list that contains it, ordered by WordClass, e.g.
+// "light" yields an adjective, a noun and a verb. The word is converted
+// to lower case and stripped of surrounding whitespace before the search.
+// To check inflected forms
+// (lights, lit), use Generator.Lemmatize.
+//
+// Returns symbols.ErrNotFound if the word is absent from all lists.
+func (gen *Generator) Lookup(word string) ([]Entry, error) {
+	word = strings.ToLower(strings.TrimSpace(word))
+
+	var entries []Entry
+
+	for wc, list := range gen.lists.Load().listed() {
+		if w, found := findWord(list, word); found {
+			entries = append(entries, Entry{Word: w, WC: wc})
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil, symbols.ErrNotFound
+	}
+
+	return entries, nil
+}
+
+// TransformEntry transforms the Word of e according to mods, treating it
+// as a member of e.WC. Refer to Generator.TransformWord for details.
+func (gen *Generator) TransformEntry(e Entry, mods Mod) (string, error) {
+	return gen.TransformWord(e.Word, e.WC, mods)
+}
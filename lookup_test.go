@@ -0,0 +1,81 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests Generator.Lookup and Generator.TransformEntry. Fails if a WordClass
+// containing the word is not reported or the entries cannot be transformed.
+func TestGenerator_Lookup(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	cases := []struct {
+		word     string
+		expected []WordClass
+	}{
+		{"light", []WordClass{WC_ADJECTIVE, WC_NOUN, WC_VERB}},
+		{" Light ", []WordClass{WC_ADJECTIVE, WC_NOUN, WC_VERB}},
+		{"otter", []WordClass{WC_NOUN}},
+		{"since", []WordClass{WC_PREPOSITION, WC_CONJUNCTION}},
+		{"ford", []WordClass{WC_NOUN, WC_VERB, WC_SURNAME}},
+	}
+
+	for _, c := range cases {
+		entries, err := gen.Lookup(c.word)
+		if err != nil {
+			t.Errorf("Failed for '%s': error returned: %v", c.word, err)
+			continue
+		}
+
+		output := make([]WordClass, len(entries))
+		for i, e := range entries {
+			output[i] = e.WC
+		}
+
+		if !slices.Equal(output, c.expected) {
+			t.Errorf("Failed for '%s': expected %v, got %v", c.word, c.expected, output)
+		}
+	}
+
+	entries, _ := gen.Lookup("light")
+	expected := []string{"lighter", "lights", "lit"}
+
+	for i, mods := range []Mod{MOD_COMPARATIVE, MOD_PLURAL, MOD_PAST_SIMPLE} {
+		output, err := gen.TransformEntry(entries[i], mods)
+		if err != nil {
+			t.Errorf("Failed for %v: error returned: %v", entries[i].WC, err)
+		} else if output != expected[i] {
+			t.Errorf("Failed for %v: expected '%s', got '%s'", entries[i].WC, expected[i], output)
+		}
+	}
+
+	for _, word := range []string{"qwzx", "hurst", ""} {
+		if _, err := gen.Lookup(word); !errors.Is(err, symbols.ErrNotFound) {
+			t.Errorf("Failed for '%s': expected ErrNotFound, got %v", word, err)
+		}
+	}
+}
@@ -17,6 +17,14 @@
 
 package neng
 
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/Zedran/neng/symbols"
+)
+
 // Mod holds modification parameters for a generated word.
 type Mod uint
 
@@ -71,21 +79,207 @@ const (
 	// Transform a word to UPPER CASE.
 	MOD_CASE_UPPER
 
+	// Precede a verb with 'will' (will run). Compatible with
+	// MOD_PERFECT and MOD_PROGRESSIVE.
+	MOD_FUTURE
+
+	// Transform a verb into its perfect form (has run). Combined with
+	// MOD_PAST_SIMPLE, yields Past Perfect (had run).
+	MOD_PERFECT
+
+	// Transform a verb into its progressive form (is running). Combined
+	// with MOD_PERFECT, yields Perfect Progressive (has been running).
+	MOD_PROGRESSIVE
+
+	// Precede a verb with 'to' (to run). Compatible with MOD_PERFECT
+	// and MOD_PROGRESSIVE.
+	MOD_INFINITIVE
+
+	// Negate a verb (does not run). Auxiliary 'do' is inserted
+	// if the verb form has no other auxiliary.
+	MOD_NEGATIVE
+
+	// Negate a verb using contracted form (doesn't run). Incompatible
+	// with MOD_NEGATIVE.
+	MOD_NEGATIVE_CONTRACTED
+
+	// Transform a verb into passive voice (is eaten).
+	MOD_PASSIVE
+
+	// Insert the definite article before an adjective or a noun.
+	MOD_DEF
+
+	// Insert 'every' before an adjective or a noun. Picks a countable,
+	// singular noun.
+	MOD_EVERY
+
+	// Insert 'some' before an adjective or a noun. Picks an uncountable
+	// or plural-only noun, unless combined with MOD_PLURAL.
+	MOD_SOME
+
+	// Insert 'many' before an adjective or a noun. Transforms the noun
+	// into its plural form and picks a countable one.
+	MOD_MANY
+
+	// Insert 'much' before an adjective or a noun. Picks an uncountable
+	// noun.
+	MOD_MUCH
+
+	// Pick a noun that is compatible with the requested determiner
+	// (MOD_INDEF, MOD_DEF, MOD_EVERY, MOD_SOME, MOD_MANY, MOD_MUCH),
+	// but do not insert the determiner. Helpful when the determiner
+	// is placed before an adjective describing the noun.
+	MOD_DET_SILENT
+
+	// Transform a number into an ordinal (42nd, forty-second).
+	MOD_ORDINAL
+
+	// Spell out a number in words (forty-two).
+	MOD_SPELLED
+
+	// Transform a pronoun into its objective case (he -> him).
+	MOD_OBJECTIVE
+
 	// Internal value, declared to mark the end of usable Mod values.
 	mod_undefined
 )
 
+// modNames holds the names of Mod constants, indexed by the position
+// of their bits.
+var modNames = [...]string{
+	"MOD_PLURAL", "MOD_PAST_SIMPLE", "MOD_PAST_PARTICIPLE", "MOD_PRESENT_SIMPLE",
+	"MOD_GERUND", "MOD_COMPARATIVE", "MOD_SUPERLATIVE", "MOD_POSSESSIVE",
+	"MOD_INDEF", "MOD_INDEF_SILENT", "MOD_CASE_LOWER", "MOD_CASE_SENTENCE",
+	"MOD_CASE_TITLE", "MOD_CASE_UPPER", "MOD_FUTURE", "MOD_PERFECT",
+	"MOD_PROGRESSIVE", "MOD_INFINITIVE", "MOD_NEGATIVE", "MOD_NEGATIVE_CONTRACTED",
+	"MOD_PASSIVE", "MOD_DEF", "MOD_EVERY", "MOD_SOME", "MOD_MANY", "MOD_MUCH",
+	"MOD_DET_SILENT", "MOD_ORDINAL", "MOD_SPELLED", "MOD_OBJECTIVE",
+}
+
 // Enabled returns true if any of the specified mods are enabled in m.
 // Do not use this method to test for MOD_NONE. Use a simple comparison instead.
 func (m Mod) Enabled(mods Mod) bool {
 	return m&mods != 0
 }
 
+// MarshalText implements encoding.TextMarshaler. The output is the same
+// as that of Mod.String. Returns symbols.ErrUndefinedMod if m holds
+// an undefined Mod value.
+func (m Mod) MarshalText() ([]byte, error) {
+	if m.Undefined() {
+		return nil, symbols.ErrUndefinedMod
+	}
+	return []byte(m.String()), nil
+}
+
+// Set implements flag.Value. It parses s with ParseMod and assigns
+// the result to m.
+func (m *Mod) Set(s string) error {
+	mods, err := ParseMod(s)
+	if err != nil {
+		return err
+	}
+
+	*m = mods
+	return nil
+}
+
+// String returns the names of Mod constants enabled in m, separated with
+// '|', e.g. "MOD_PLURAL|MOD_CASE_TITLE". Returns "MOD_NONE" if no Mod
+// is enabled. Undefined bits are reported as a single hexadecimal value,
+// e.g. "MOD_PLURAL|Mod(0x10000)".
+func (m Mod) String() string {
+	if m == MOD_NONE {
+		return "MOD_NONE"
+	}
+
+	var names []string
+
+	for b := m & (mod_undefined - 1); b != 0; b &= b - 1 {
+		names = append(names, modNames[bits.TrailingZeros(uint(b))])
+	}
+
+	if m.Undefined() {
+		names = append(names, fmt.Sprintf("Mod(%#x)", uint(m&^(mod_undefined-1))))
+	}
+
+	return strings.Join(names, "|")
+}
+
 // Undefined returns true if m holds an undefined Mod value.
 func (m Mod) Undefined() bool {
 	return m >= mod_undefined
 }
 
+// UnmarshalJSON implements json.Unmarshaler. In addition to the string
+// produced by Mod.MarshalText, it accepts the numeric value
+// of the Mod. Returns symbols.ErrUndefinedMod if the value is undefined.
+func (m *Mod) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, m, Mod.Undefined, symbols.ErrUndefinedMod, m.UnmarshalText)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It parses text
+// with ParseMod.
+func (m *Mod) UnmarshalText(text []byte) error {
+	return m.Set(string(text))
+}
+
+// ParseMod converts s into a Mod value. It accepts two notations:
+//   - names of Mod constants separated with '|', e.g. "MOD_PLURAL|MOD_CASE_TITLE"
+//     (case-insensitive, whitespace around the names is ignored)
+//   - Phrase transformation specifiers, e.g. "pt" (see Generator.Phrase)
+//
+// An empty string (or one consisting of whitespace only) yields MOD_NONE.
+//
+// Returns symbols.ErrUndefinedMod if an unknown name is encountered
+// or symbols.ErrUndefinedSpecifier if an unknown specifier is encountered.
+func ParseMod(s string) (Mod, error) {
+	s = strings.TrimSpace(s)
+
+	if len(s) == 0 {
+		return MOD_NONE, nil
+	}
+
+	var mods Mod
+
+	if !strings.Contains(strings.ToUpper(s), "MOD_") {
+		for _, c := range s {
+			m := specToMod(c)
+			if m == mod_undefined {
+				return MOD_NONE, fmt.Errorf("'%c': %w", c, symbols.ErrUndefinedSpecifier)
+			}
+			mods |= m
+		}
+		return mods, nil
+	}
+
+	for _, name := range strings.Split(s, "|") {
+		m, err := nameToMod(strings.ToUpper(strings.TrimSpace(name)))
+		if err != nil {
+			return MOD_NONE, err
+		}
+		mods |= m
+	}
+
+	return mods, nil
+}
+
+// nameToMod translates the name of a single Mod constant into its value.
+// Returns symbols.ErrUndefinedMod if the name is not recognized.
+func nameToMod(name string) (Mod, error) {
+	if name == "MOD_NONE" {
+		return MOD_NONE, nil
+	}
+
+	for i, n := range modNames {
+		if n == name {
+			return 1 << i, nil
+		}
+	}
+
+	return MOD_NONE, fmt.Errorf("'%s': %w", name, symbols.ErrUndefinedMod)
+}
+
 // specToMod translates specifier (phrase pattern syntax character)
 // into a corresponding Mod value.
 func specToMod(spec rune) Mod {
@@ -96,10 +290,38 @@ func specToMod(spec rune) Mod {
 		return MOD_PAST_SIMPLE
 	case '3':
 		return MOD_PAST_PARTICIPLE
+	case 'E':
+		return MOD_EVERY
+	case 'F':
+		return MOD_FUTURE
+	case 'G':
+		return MOD_PROGRESSIVE
+	case 'H':
+		return MOD_PERFECT
+	case 'I':
+		return MOD_INFINITIVE
+	case 'M':
+		return MOD_MANY
 	case 'N':
 		return MOD_PRESENT_SIMPLE
+	case 'O':
+		return MOD_OBJECTIVE
+	case 'R':
+		return MOD_ORDINAL
+	case 'S':
+		return MOD_SOME
+	case 'U':
+		return MOD_MUCH
+	case 'W':
+		return MOD_SPELLED
+	case 'X':
+		return MOD_NEGATIVE_CONTRACTED
+	case 'b':
+		return MOD_PASSIVE
 	case 'c':
 		return MOD_COMPARATIVE
+	case 'd':
+		return MOD_DEF
 	case 'f':
 		return MOD_CASE_SENTENCE
 	case 'g':
@@ -116,8 +338,12 @@ func specToMod(spec rune) Mod {
 		return MOD_CASE_TITLE
 	case 'u':
 		return MOD_CASE_UPPER
+	case 'x':
+		return MOD_NEGATIVE
 	case '_':
 		return MOD_INDEF_SILENT
+	case '~':
+		return MOD_DET_SILENT
 	default:
 		return mod_undefined
 	}
@@ -17,7 +17,16 @@
 
 package neng
 
-import "testing"
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math/bits"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
 
 // Tests whether Mod methods return the correct results.
 func TestMod(t *testing.T) {
@@ -53,3 +62,116 @@ func TestMod(t *testing.T) {
 		t.Error("False positive reported - group")
 	}
 }
+
+// Tests whether Mod.String returns the correct names and whether every
+// defined Mod value has a name.
+func TestMod_String(t *testing.T) {
+	if n := bits.Len(uint(mod_undefined - 1)); n != len(modNames) {
+		t.Fatalf("Failed: %d Mod values defined, but %d names present", n, len(modNames))
+	}
+
+	cases := map[Mod]string{
+		MOD_NONE:                           "MOD_NONE",
+		MOD_PLURAL:                         "MOD_PLURAL",
+		MOD_CASE_UPPER:                     "MOD_CASE_UPPER",
+		MOD_CASE_TITLE | MOD_PLURAL:        "MOD_PLURAL|MOD_CASE_TITLE",
+		MOD_GERUND | mod_undefined:         "MOD_GERUND|Mod(" + fmt.Sprintf("%#x", uint(mod_undefined)) + ")",
+		MOD_INDEF | MOD_POSSESSIVE:         "MOD_POSSESSIVE|MOD_INDEF",
+		MOD_PAST_SIMPLE | MOD_INDEF_SILENT: "MOD_PAST_SIMPLE|MOD_INDEF_SILENT",
+	}
+
+	for m, expected := range cases {
+		if out := m.String(); out != expected {
+			t.Errorf("Failed for %d: expected '%s', got '%s'", uint(m), expected, out)
+		}
+	}
+
+	for i := MOD_PLURAL; i < mod_undefined; i <<= 1 {
+		if m, err := ParseMod(i.String()); err != nil || m != i {
+			t.Errorf("Failed for %s: parsed into %d, error: %v", i, uint(m), err)
+		}
+	}
+}
+
+// Tests whether ParseMod accepts both notations and rejects unknown names
+// and specifiers.
+func TestParseMod(t *testing.T) {
+	type testCase struct {
+		input    string
+		expected Mod
+		err      error
+	}
+
+	cases := []testCase{
+		{"", MOD_NONE, nil},
+		{"  ", MOD_NONE, nil},
+		{"MOD_NONE", MOD_NONE, nil},
+		{"MOD_PLURAL|MOD_CASE_TITLE", MOD_PLURAL | MOD_CASE_TITLE, nil},
+		{" mod_plural | Mod_Case_Title ", MOD_PLURAL | MOD_CASE_TITLE, nil},
+		{"MOD_GERUND|MOD_NONE", MOD_GERUND, nil},
+		{"pt", MOD_PLURAL | MOD_CASE_TITLE, nil},
+		{"2pN", MOD_PAST_SIMPLE | MOD_PLURAL | MOD_PRESENT_SIMPLE, nil},
+		{"_", MOD_INDEF_SILENT, nil},
+		{"MOD_PLURAL|MOD_UNKNOWN", MOD_NONE, symbols.ErrUndefinedMod},
+		{"MOD_PLURAL|", MOD_NONE, symbols.ErrUndefinedMod},
+		{"pq", MOD_NONE, symbols.ErrUndefinedSpecifier},
+		{"%p", MOD_NONE, symbols.ErrUndefinedSpecifier},
+	}
+
+	for _, c := range cases {
+		out, err := ParseMod(c.input)
+
+		if c.err != nil {
+			if !errors.Is(err, c.err) {
+				t.Errorf("Failed for '%s': expected error '%v', got '%v'", c.input, c.err, err)
+			}
+		} else if err != nil || out != c.expected {
+			t.Errorf("Failed for '%s': expected %s, got %s, error: %v", c.input, c.expected, out, err)
+		}
+	}
+}
+
+// Tests whether Mod can be encoded and decoded as JSON and used as flag.Value.
+func TestMod_Marshal(t *testing.T) {
+	type config struct {
+		Mods Mod `json:"mods"`
+	}
+
+	data, err := json.Marshal(config{MOD_PLURAL | MOD_CASE_UPPER})
+	if err != nil || string(data) != `{"mods":"MOD_PLURAL|MOD_CASE_UPPER"}` {
+		t.Errorf("Failed: unexpected JSON: %s, error: %v", data, err)
+	}
+
+	for input, expected := range map[string]Mod{
+		`{"mods":"MOD_PLURAL|MOD_CASE_UPPER"}`: MOD_PLURAL | MOD_CASE_UPPER,
+		`{"mods":"pu"}`:                        MOD_PLURAL | MOD_CASE_UPPER,
+		`{"mods":8193}`:                        MOD_PLURAL | MOD_CASE_UPPER,
+	} {
+		var c config
+		if err := json.Unmarshal([]byte(input), &c); err != nil || c.Mods != expected {
+			t.Errorf("Failed for %s: got %s, error: %v", input, c.Mods, err)
+		}
+	}
+
+	for _, input := range []string{fmt.Sprintf(`{"mods":%d}`, uint64(mod_undefined)), `{"mods":1099511627776}`, `{"mods":"MOD_X"}`} {
+		var c config
+		if err := json.Unmarshal([]byte(input), &c); !errors.Is(err, symbols.ErrUndefinedMod) {
+			t.Errorf("Failed for %s: expected ErrUndefinedMod, got %v", input, err)
+		}
+	}
+
+	if _, err = json.Marshal(config{mod_undefined}); err == nil {
+		t.Error("Failed: undefined Mod was marshaled")
+	}
+
+	var (
+		fs   = flag.NewFlagSet("test", flag.ContinueOnError)
+		mods Mod
+	)
+
+	fs.Var(&mods, "mods", "")
+
+	if err = fs.Parse([]string{"-mods", "MOD_GERUND|MOD_CASE_TITLE"}); err != nil || mods != MOD_GERUND|MOD_CASE_TITLE {
+		t.Errorf("Failed: flag parsed into %s, error: %v", mods, err)
+	}
+}
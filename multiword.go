@@ -0,0 +1,179 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"slices"
+	"strings"
+)
+
+// headParticles lists prepositions and adverbial particles that follow
+// the head of a multi-word noun (mother-in-law, passer-by).
+var headParticles = map[string]struct{}{
+	"about": {}, "after": {}, "at": {}, "away": {}, "by": {}, "down": {},
+	"for": {}, "from": {}, "in": {}, "of": {}, "off": {}, "on": {},
+	"out": {}, "over": {}, "to": {}, "up": {}, "with": {},
+}
+
+// isMultiWord returns true if word consists of elements separated
+// by spaces or hyphens.
+func isMultiWord(word string) bool {
+	return strings.ContainsAny(word, " -")
+}
+
+// splitHead splits a multi-word entry into the inflected head word
+// and the elements preceding and following it. The head of a noun
+// is the noun preceding the first inner preposition (mother-in-law,
+// bill of rights), an agent noun followed by a particle (passer-by)
+// or the last element (forget-me-not, take-off, good-for-nothing).
+// The head of a verb is its first space-separated element (look up) or,
+// if that element is hyphenated, the last hyphenated element of it
+// (dry-clean).
+func splitHead(word string, wc WordClass, opts InflectOptions) (pre, head, post string) {
+	if wc == WC_VERB {
+		end := strings.IndexByte(word, ' ')
+		if end == -1 {
+			end = len(word)
+		}
+		start := strings.LastIndexByte(word[:end], '-') + 1
+		return word[:start], word[start:end], word[end:]
+	}
+
+	// Boundaries of the elements
+	bounds := make([][2]int, 0, 4)
+	start := 0
+	for i := 0; i <= len(word); i++ {
+		if i == len(word) || word[i] == ' ' || word[i] == '-' {
+			bounds = append(bounds, [2]int{start, i})
+			start = i + 1
+		}
+	}
+
+	h := len(bounds) - 1
+	for i := 1; i < len(bounds); i++ {
+		if _, ok := headParticles[word[bounds[i][0]:bounds[i][1]]]; !ok {
+			continue
+		}
+		candidate := word[bounds[i-1][0]:bounds[i-1][1]]
+		if (i < len(bounds)-1 || isAgentNoun(candidate, opts)) && isNounHead(candidate, opts) {
+			h = i - 1
+		}
+		break
+	}
+
+	return word[:bounds[h][0]], word[bounds[h][0]:bounds[h][1]], word[bounds[h][1]:]
+}
+
+// isAgentNoun returns true if noun is derived from a listed verb
+// with the '-er' suffix (passer, runner, diver), so that the particle
+// following it belongs to the verb (passer-by), rather than forms
+// a noun with it (cover-up). Returns false if opts holds no access
+// to the word lists.
+func isAgentNoun(noun string, opts InflectOptions) bool {
+	if opts.find == nil || !strings.HasSuffix(noun, "er") {
+		return false
+	}
+
+	stem := noun[:len(noun)-2]
+	candidates := []string{stem, noun[:len(noun)-1]}
+
+	// Doubled final consonant (runner)
+	if n := len(stem); n > 1 && stem[n-1] == stem[n-2] && !strings.ContainsRune("aeiou", rune(stem[n-1])) {
+		candidates = append(candidates, stem[:n-1])
+	}
+
+	for _, verb := range candidates {
+		if _, ok := opts.find(verb, WC_VERB); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isNounHead returns true if elem can be the inner head of a multi-word
+// noun - a listed noun that is neither an adjective, a particle nor
+// a number (good-for-nothing, out-of-towner, two-by-four). Returns false
+// if opts holds no access to the word lists.
+func isNounHead(elem string, opts InflectOptions) bool {
+	if opts.find == nil {
+		return false
+	}
+
+	if _, ok := headParticles[elem]; ok || slices.Contains(numUnits[:], elem) || slices.Contains(numTens[:], elem) {
+		return false
+	}
+
+	if _, ok := opts.find(elem, WC_NOUN); !ok {
+		return false
+	}
+
+	_, adj := opts.find(elem, WC_ADJECTIVE)
+	return !adj
+}
+
+// inflectMultiWord inflects the head of a regular multi-word noun or verb
+// and reassembles the entry. The irregular forms of the head are
+// taken from opts.find, e.g. "man-of-war" -> "men-of-war". Elements
+// of a hyphenated verb are prepended to the last element of its
+// inflected head, so that the auxiliaries of compound tenses precede
+// the whole verb: "dry-clean" -> "has dry-cleaned".
+func inflectMultiWord(word Word, wc WordClass, mods Mod, opts InflectOptions) string {
+	pre, head, post := splitHead(word.word, wc, opts)
+
+	hw := Word{word: head}
+	if opts.find != nil {
+		if w, ok := opts.find(head, wc); ok && w.ft == FT_IRREGULAR {
+			hw = w
+		}
+	}
+
+	// The forms set for the whole entry replace the inflected head
+	hopts := opts
+	hopts.form = nil
+
+	if wc == WC_NOUN {
+		w, ok := opts.override(MOD_PLURAL)
+		if !ok {
+			w = pre + plural(hw, hopts) + post
+		}
+		if mods.Enabled(MOD_POSSESSIVE) {
+			w = possessive(w, true)
+		}
+		return w
+	}
+
+	if opts.form != nil {
+		hopts.form = func(m Mod) (string, bool) {
+			f, ok := opts.override(m)
+			if !ok {
+				return "", false
+			}
+			if len(f) >= len(pre)+len(post) && strings.HasPrefix(f, pre) && strings.HasSuffix(f, post) {
+				return f[len(pre) : len(f)-len(post)], true
+			}
+			// The form does not keep the remaining elements in place
+			pre, post = "", ""
+			return f, true
+		}
+	}
+
+	form := English{}.Inflect(hw, wc, mods, hopts)
+	last := strings.LastIndexByte(form, ' ') + 1
+
+	return form[:last] + pre + form[last:] + post
+}
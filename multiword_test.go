@@ -0,0 +1,64 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"testing"
+
+	"github.com/Zedran/neng/internal/tests"
+)
+
+// Tests inflection and casing of hyphenated and multi-word nouns
+// and verbs. Fails if the head word is not inflected or the case
+// of the elements is incorrect.
+func TestMultiWord(t *testing.T) {
+	type testCase struct {
+		Input    string    `json:"input"`
+		WC       WordClass `json:"word_class"`
+		Mods     Mod       `json:"mods"`
+		Expected string    `json:"expected"`
+		FT       FormType  `json:"form_type"`
+	}
+
+	var cases []testCase
+	if err := tests.ReadData("TestMultiWord.json", &cases); err != nil {
+		t.Fatalf("Failed loading test data: %v", err)
+	}
+
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	for _, c := range cases {
+		word, err := NewWordFromParams(c.Input, c.FT, nil)
+		if err != nil {
+			t.Fatalf("Failed for '%s' - error from NewWordFromParams: %v", c.Input, err)
+		}
+
+		output, err := gen.TransformWord(word, c.WC, c.Mods)
+		if err != nil {
+			t.Errorf("Failed for '%s' (%s, %s): error returned: %v", c.Input, c.WC, c.Mods, err)
+			continue
+		}
+
+		if output != c.Expected {
+			t.Errorf("Failed for '%s' (%s, %s): expected '%s', got '%s'", c.Input, c.WC, c.Mods, c.Expected, output)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import "github.com/Zedran/neng/symbols"
+
+// morphemes holds the building blocks of place names.
+type morphemes struct {
+	// Stems beginning the names (dun)
+	stems []string
+
+	// Suffixes ending the names (mere)
+	suffixes []string
+}
+
+// GivenName generates a single random given name and transforms it
+// according to mods. Names are written in Title Case, unless a case Mod
+// is received. Returns an error if an undefined or incompatible Mod
+// is received.
+func (gen *Generator) GivenName(mods Mod) (string, error) {
+	return gen.generateWord(WC_GIVEN_NAME, mods, gen.accept(nil))
+}
+
+// Place generates a single random place name and transforms it according
+// to mods. The name is built from two embedded morphemes: a stem followed
+// by a suffix, e.g. "dun" and "mere" yield "Dunmere".
+//
+// Returns an error if:
+//   - an undefined Mod is received (relays from Generator.TransformWord)
+//   - an incompatible Mod is received (relays from Generator.TransformWord)
+//   - Generator.iterLimit is reached while attempting to draw both
+//     morphemes (relevant for generators with customized word lists)
+func (gen *Generator) Place(mods Mod) (string, error) {
+	return gen.place(mods, gen.accept(nil))
+}
+
+// place is the implementation of Generator.Place, which only returns
+// names accepted by accept.
+func (gen *Generator) place(mods Mod, accept func(Word) bool) (string, error) {
+	for range gen.iterLimit {
+		stem := gen.places.stems[gen.randIndex(len(gen.places.stems))]
+		suffix := gen.places.suffixes[gen.randIndex(len(gen.places.suffixes))]
+
+		name := Word{word: joinMorphemes(stem, suffix)}
+		if accept == nil || accept(name) {
+			return gen.TransformWord(name, WC_PLACE, mods)
+		}
+	}
+
+	return "", symbols.ErrIterLimit
+}
+
+// Surname generates a single random surname and transforms it according
+// to mods. Names are written in Title Case, unless a case Mod is received.
+// Returns an error if an undefined or incompatible Mod is received.
+func (gen *Generator) Surname(mods Mod) (string, error) {
+	return gen.generateWord(WC_SURNAME, mods, gen.accept(nil))
+}
+
+// joinMorphemes appends suffix to stem. If the stem ends with the letter
+// that begins the suffix, the letter is written once (ash + hurst = ashurst).
+func joinMorphemes(stem, suffix string) string {
+	if stem[len(stem)-1] == suffix[0] {
+		return stem + suffix[1:]
+	}
+	return stem + suffix
+}
@@ -0,0 +1,119 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests joinMorphemes. Fails if morphemes are joined incorrectly.
+func TestJoinMorphemes(t *testing.T) {
+	cases := []struct {
+		stem, suffix, expected string
+	}{
+		{"dun", "mere", "dunmere"},
+		{"ash", "hurst", "ashurst"},
+		{"kings", "stoke", "kingstoke"},
+		{"brad", "ford", "bradford"},
+	}
+
+	for _, c := range cases {
+		if output := joinMorphemes(c.stem, c.suffix); output != c.expected {
+			t.Errorf("Failed for '%s' + '%s': expected '%s', got '%s'", c.stem, c.suffix, c.expected, output)
+		}
+	}
+}
+
+// Tests whether Generator.Place builds names from an embedded stem
+// and suffix and writes them in Title Case.
+func TestGenerator_Place(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	for range 100 {
+		p, err := gen.Place(MOD_NONE)
+		if err != nil {
+			t.Fatalf("Failed: error returned: %v", err)
+		}
+
+		if p != gen.caser.toTitle(p) {
+			t.Errorf("Failed: '%s' is not in Title Case", p)
+		}
+
+		lp := strings.ToLower(p)
+
+		found := false
+		for _, suf := range gen.places.suffixes {
+			if stem, ok := strings.CutSuffix(lp, suf); ok && len(stem) > 0 {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Errorf("Failed: '%s' does not end with a suffix from the list", p)
+		}
+	}
+}
+
+// Tests whether the methods accessing word lists reject WC_PLACE
+// and whether Generator.Export and Generator.Sizes omit it.
+func TestGenerator_PlaceList(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	w, _ := NewWord("0dun")
+
+	for name, f := range map[string]func() error{
+		"AddWord":     func() error { return gen.AddWord(w, WC_PLACE) },
+		"All":         func() error { _, err := gen.All(WC_PLACE); return err },
+		"ExportList":  func() error { return gen.ExportList(&bytes.Buffer{}, WC_PLACE, LF_NATIVE) },
+		"Find":        func() error { _, err := gen.Find("dun", WC_PLACE); return err },
+		"Len":         func() error { _, err := gen.Len(WC_PLACE); return err },
+		"RemoveWord":  func() error { return gen.RemoveWord("dun", WC_PLACE) },
+		"ReplaceList": func() error { return gen.ReplaceList([]Word{w}, WC_PLACE) },
+		"Search":      func() error { _, err := gen.Search("dun", WC_PLACE, SearchOptions{}); return err },
+		"Words":       func() error { _, err := gen.Words(WC_PLACE); return err },
+	} {
+		if err := f(); !errors.Is(err, symbols.ErrNoWordList) {
+			t.Errorf("Failed for %s: expected ErrNoWordList, got %v", name, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := gen.Export(&buf, LF_NATIVE); err != nil {
+		t.Fatalf("Failed: Export returned an error: %v", err)
+	}
+
+	if strings.Contains(buf.String(), WC_PLACE.String()) {
+		t.Error("Failed: Export wrote the header of WC_PLACE")
+	}
+
+	if _, ok := gen.Sizes()[WC_PLACE]; ok {
+		t.Error("Failed: Sizes reported WC_PLACE")
+	}
+}
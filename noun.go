@@ -19,8 +19,16 @@ package neng
 
 import "strings"
 
-// plural returns plural form of a noun.
-func plural(word Word) string {
+// plural returns plural form of a noun. Nouns ending with an entry
+// of EX_UNCHANGED_PLURAL in opts.exceptions are returned unchanged,
+// while those ending with an entry of EX_MEN_PLURAL, but not
+// of EX_MANS_PLURAL, take '-men'. The form set with Generator.SetForm
+// takes precedence over the rules.
+func plural(word Word, opts InflectOptions) string {
+	if form, ok := opts.override(MOD_PLURAL); ok {
+		return form
+	}
+
 	switch word.ft {
 	case FT_IRREGULAR:
 		return (*word.irr)[0]
@@ -30,6 +38,10 @@ func plural(word Word) string {
 
 	noun := word.word
 
+	if opts.exceptions.matchSuffix(EX_UNCHANGED_PLURAL, noun) {
+		return noun
+	}
+
 	switch noun[len(noun)-1] {
 	case 'e':
 		if endsWithAny(noun, []string{"life", "knife", "wife"}) {
@@ -70,22 +82,15 @@ func plural(word Word) string {
 	case "um":
 		return noun[:len(noun)-2] + "a"
 	case "sh":
-		if strings.HasSuffix(noun, "fish") {
-			return noun
-		}
 		return noun + "es"
 	case "ch":
 		return noun + "es"
 	}
 
-	if strings.HasSuffix(noun, "man") {
+	if opts.exceptions.matchSuffix(EX_MEN_PLURAL, noun) && !opts.exceptions.matchSuffix(EX_MANS_PLURAL, noun) {
 		return noun[:len(noun)-2] + "en"
 	}
 
-	if strings.HasSuffix(noun, "craft") {
-		return noun
-	}
-
 	return noun + "s"
 }
 
@@ -46,7 +46,7 @@ func TestPlural(t *testing.T) {
 			}
 		}
 
-		output := plural(word)
+		output := plural(word, InflectOptions{})
 
 		if output != expected {
 			t.Errorf("Failed for '%s': expected '%s', got '%s'", input, expected, output)
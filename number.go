@@ -0,0 +1,268 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Default range of numbers inserted by Generator.Phrase
+// if no range is specified.
+const (
+	DEFAULT_NUM_MIN int = 1
+	DEFAULT_NUM_MAX int = 99
+)
+
+// Names of numbers below twenty and of tens, indexed by their values.
+var (
+	numUnits = [...]string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight",
+		"nine", "ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen",
+		"sixteen", "seventeen", "eighteen", "nineteen",
+	}
+
+	numTens = [...]string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy",
+		"eighty", "ninety",
+	}
+
+	// Names of powers of a thousand, indexed by their exponents
+	numScales = [...]string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion",
+		"quintillion",
+	}
+
+	// Irregular ordinal forms
+	numOrdinals = map[string]string{
+		"one": "first", "two": "second", "three": "third", "five": "fifth",
+		"eight": "eighth", "nine": "ninth", "twelve": "twelfth",
+	}
+)
+
+// Number generates a random integer in range [min, max] and formats it
+// according to mods. The number is written in digits, unless MOD_SPELLED
+// is enabled, and transformed into an ordinal if MOD_ORDINAL is enabled
+// (42nd, forty-second). Case transformations are also applied.
+//
+// Returns an error if:
+//   - min is greater than max
+//   - an undefined Mod is received
+//   - any of the mods other than MOD_SPELLED, MOD_ORDINAL
+//     and case transformations is received
+//   - the Generator is restricted to ASCII words and the formatted
+//     number is not eligible (see DefaultGeneratorASCII)
+func (gen *Generator) Number(min, max int, mods Mod) (string, error) {
+	s, _, err := gen.number(min, max, mods)
+	return s, err
+}
+
+// agreeWith returns mods extended with MOD_PLURAL if n requires
+// the plural form of a noun. Otherwise, unless a determiner
+// or MOD_PLURAL is already requested, extends mods with MOD_INDEF_SILENT
+// to pick a countable noun.
+func agreeWith(n int, mods Mod) Mod {
+	if n != 1 && n != -1 {
+		return mods | MOD_PLURAL
+	}
+
+	if !mods.Enabled(mod_determiner | MOD_INDEF_SILENT | MOD_PLURAL) {
+		mods |= MOD_INDEF_SILENT
+	}
+	return mods
+}
+
+// formatNumber formats n according to MOD_SPELLED and MOD_ORDINAL.
+func formatNumber(n int, mods Mod) string {
+	if !mods.Enabled(MOD_SPELLED) {
+		s := strconv.Itoa(n)
+		if mods.Enabled(MOD_ORDINAL) {
+			s += ordinalSuffix(n)
+		}
+		return s
+	}
+
+	s := spellNumber(n)
+	if mods.Enabled(MOD_ORDINAL) {
+		s = spellOrdinal(s)
+	}
+	return s
+}
+
+// ordinalSuffix returns the suffix of the ordinal number written in digits
+// (1st, 2nd, 3rd, 4th, 11th).
+func ordinalSuffix(n int) string {
+	u := uint64(n)
+	if n < 0 {
+		u = -u
+	}
+
+	if u%100 >= 11 && u%100 <= 13 {
+		return "th"
+	}
+
+	switch u % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	default:
+		return "th"
+	}
+}
+
+// spellNumber returns n spelled out in words, e.g. "one hundred forty-two".
+// Negative numbers are preceded by "minus".
+func spellNumber(n int) string {
+	if n == 0 {
+		return numUnits[0]
+	}
+
+	var words []string
+
+	// Unsigned magnitude of n, valid for math.MinInt as well
+	u := uint64(n)
+	if n < 0 {
+		words = append(words, "minus")
+		u = -u
+	}
+
+	// Groups of three digits, from the least significant one
+	var groups []uint64
+	for ; u > 0; u /= 1000 {
+		groups = append(groups, u%1000)
+	}
+
+	for i := len(groups) - 1; i >= 0; i-- {
+		if groups[i] == 0 {
+			continue
+		}
+
+		words = append(words, spellHundreds(groups[i])...)
+		if i > 0 {
+			words = append(words, numScales[i])
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// spellHundreds returns words representing n in range [1, 999].
+func spellHundreds(n uint64) []string {
+	var words []string
+
+	if n >= 100 {
+		words = append(words, numUnits[n/100], "hundred")
+		n %= 100
+	}
+
+	switch true {
+	case n == 0:
+	case n < 20:
+		words = append(words, numUnits[n])
+	case n%10 == 0:
+		words = append(words, numTens[n/10])
+	default:
+		words = append(words, numTens[n/10]+"-"+numUnits[n%10])
+	}
+
+	return words
+}
+
+// spellOrdinal transforms a spelled-out number into an ordinal
+// by transforming its final word (forty-two -> forty-second).
+func spellOrdinal(s string) string {
+	i := strings.LastIndexAny(s, " -") + 1
+	last := s[i:]
+
+	if o, ok := numOrdinals[last]; ok {
+		return s[:i] + o
+	}
+
+	if strings.HasSuffix(last, "y") {
+		return s[:i] + last[:len(last)-1] + "ieth"
+	}
+
+	return s + "th"
+}
+
+// number is the implementation of Generator.Number that additionally
+// returns the generated integer.
+func (gen *Generator) number(min, max int, mods Mod) (string, int, error) {
+	if min > max {
+		return "", 0, symbols.ErrBadRange
+	}
+
+	if mods.Undefined() {
+		return "", 0, symbols.ErrUndefinedMod
+	}
+
+	if mods.Enabled(^(MOD_SPELLED | MOD_ORDINAL | mod_case)) {
+		return "", 0, symbols.ErrIncompatible
+	}
+
+	n := gen.randInt(min, max)
+
+	num := gen.lang.Number(n, mods)
+	if err := gen.checkASCIINumber(num); err != nil {
+		return "", 0, err
+	}
+
+	return gen.caser.apply(num, mods), n, nil
+}
+
+// parseRange parses number range specification of Generator.Phrase
+// in the form of "min-max", e.g. "1-100" or "-10--5".
+func parseRange(s string) (int, int, error) {
+	// Skip the sign of min
+	i := strings.IndexByte(s[min(1, len(s)):], '-') + 1
+	if i == 0 {
+		return 0, 0, symbols.ErrBadRange
+	}
+
+	lo, err := strconv.Atoi(s[:i])
+	if err != nil {
+		return 0, 0, symbols.ErrBadRange
+	}
+
+	hi, err := strconv.Atoi(s[i+1:])
+	if err != nil || lo > hi {
+		return 0, 0, symbols.ErrBadRange
+	}
+
+	return lo, hi, nil
+}
+
+// randInt returns a random integer in range [min, max]. Does not check
+// whether min <= max.
+func (gen *Generator) randInt(min, max int) int {
+	span := uint64(max) - uint64(min)
+
+	gen.mu.Lock()
+	defer gen.mu.Unlock()
+
+	if span == math.MaxUint64 {
+		return int(gen.source.Uint64())
+	}
+	return min + int(gen.source.Uint64N(span+1))
+}
@@ -0,0 +1,123 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/Zedran/neng/internal/tests"
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests formatNumber. Fails if a number is incorrectly written in digits
+// or words, or incorrectly transformed into an ordinal.
+func TestFormatNumber(t *testing.T) {
+	type testCase struct {
+		Input    int    `json:"input"`
+		Mods     Mod    `json:"mods"`
+		Expected string `json:"expected"`
+	}
+
+	var cases []testCase
+	if err := tests.ReadData("TestFormatNumber.json", &cases); err != nil {
+		t.Fatalf("Failed loading test data: %v", err)
+	}
+
+	for _, c := range cases {
+		output := formatNumber(c.Input, c.Mods)
+
+		if output != c.Expected {
+			t.Errorf("Failed for %d (%s): expected '%s', got '%s'", c.Input, c.Mods, c.Expected, output)
+		}
+	}
+}
+
+// Tests Generator.Number. Fails if a number outside the requested range
+// is generated or if invalid arguments are accepted.
+func TestGenerator_Number(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	seen := make(map[string]bool)
+
+	for range 1000 {
+		n, err := gen.Number(-2, 2, MOD_NONE)
+		if err != nil {
+			t.Fatalf("Failed: error returned: %v", err)
+		}
+		seen[n] = true
+	}
+
+	if len(seen) != 5 || !seen["-2"] || !seen["2"] {
+		t.Errorf("Failed: unexpected set of numbers generated: %v", seen)
+	}
+
+	if n, err := gen.Number(3, 3, MOD_SPELLED|MOD_ORDINAL|MOD_CASE_TITLE); err != nil || n != "Third" {
+		t.Errorf("Failed: expected 'Third', got '%s', %v", n, err)
+	}
+
+	if _, err := gen.Number(math.MinInt, math.MaxInt, MOD_SPELLED); err != nil {
+		t.Errorf("Failed for the full range of int: %v", err)
+	}
+
+	errCases := []struct {
+		min, max int
+		mods     Mod
+		expected error
+	}{
+		{2, 1, MOD_NONE, symbols.ErrBadRange},
+		{1, 2, MOD_PLURAL, symbols.ErrIncompatible},
+		{1, 2, mod_undefined, symbols.ErrUndefinedMod},
+	}
+
+	for _, c := range errCases {
+		if n, err := gen.Number(c.min, c.max, c.mods); !errors.Is(err, c.expected) {
+			t.Errorf("Failed for %v: expected %v, got '%s', %v", c, c.expected, n, err)
+		}
+	}
+}
+
+// Tests parseRange. Fails if a valid range specification is rejected
+// or an invalid one is accepted.
+func TestParseRange(t *testing.T) {
+	goodCases := map[string][2]int{
+		"1-100":   {1, 100},
+		"0-0":     {0, 0},
+		"-10--5":  {-10, -5},
+		"-3-3":    {-3, 3},
+		"+1-+2":   {1, 2},
+		"007-010": {7, 10},
+	}
+
+	for input, expected := range goodCases {
+		lo, hi, err := parseRange(input)
+		if err != nil || lo != expected[0] || hi != expected[1] {
+			t.Errorf("Failed for '%s': expected %v, got %d, %d, %v", input, expected, lo, hi, err)
+		}
+	}
+
+	for _, input := range []string{"", "-", "1", "1-", "-1", "a-b", "5-1", "1 - 2", "1-2-3"} {
+		if lo, hi, err := parseRange(input); !errors.Is(err, symbols.ErrBadRange) {
+			t.Errorf("Failed for '%s': expected ErrBadRange, got %d, %d, %v", input, lo, hi, err)
+		}
+	}
+}
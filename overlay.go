@@ -0,0 +1,148 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Overlay describes modifications applied to a single word list.
+type Overlay struct {
+	// Words added to the list. Adding a word that is already present
+	// in the list has no effect, as long as its FormType and irregular
+	// forms are the same as those of the existing entry.
+	Add []Word
+
+	// Words removed from the list. Removals are performed before
+	// additions, so a word can be both removed and added in order
+	// to change its FormType.
+	Remove []string
+}
+
+// DefaultGeneratorWithOverlay returns a new Generator with default word lists
+// modified by overlays. overlays maps WordClass to modifications of the word
+// list corresponding to it. Word lists without an Overlay remain unchanged.
+// The resulting lists remain sorted, so Generator.Find works as expected.
+//
+// Returns an error if:
+//   - overlays contains an undefined WordClass
+//   - a word to be removed is not present in the list
+//   - an added word is already present in the list with a different
+//     FormType or different irregular forms
+//   - a list becomes empty as a result of removals
+//
+// src is the source of random numbers. If src is nil, a new, randomly seeded
+// rand.PCG is created.
+func DefaultGeneratorWithOverlay(overlays map[WordClass]Overlay, src *rand.Rand) (*Generator, error) {
+	gen, err := DefaultGenerator(src)
+	if err != nil {
+		return nil, err
+	}
+
+	for wc, o := range overlays {
+		err := gen.modifyList(wc, func(list []Word) ([]Word, error) {
+			list, err := o.apply(list)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(list) == 0 {
+				return nil, symbols.ErrEmptyLists
+			}
+			return list, nil
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", wc, err)
+		}
+	}
+
+	return gen, nil
+}
+
+// apply returns a copy of the sorted list with the Overlay applied.
+// The copy remains sorted. Relays errors from insertWord and removeWord.
+func (o Overlay) apply(list []Word) ([]Word, error) {
+	list = slices.Clone(list)
+
+	var err error
+
+	for _, w := range o.Remove {
+		if list, err = removeWord(list, w); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, w := range o.Add {
+		if list, err = insertWord(list, w); err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
+
+// insertWord inserts w into the sorted list, maintaining the order.
+// If an identical entry already exists, the list is returned unchanged.
+// Returns symbols.ErrConflictingWord if the list contains an entry
+// with the same word, but different FormType or irregular forms.
+func insertWord(list []Word, w Word) ([]Word, error) {
+	i, found := slices.BinarySearchFunc(list, w, cmpWord)
+	if !found {
+		return slices.Insert(list, i, w), nil
+	}
+
+	if !sameForms(list[i], w) {
+		return nil, fmt.Errorf("'%s': %w", w.word, symbols.ErrConflictingWord)
+	}
+
+	return list, nil
+}
+
+// removeWord removes word from the sorted list. Returns symbols.ErrNotFound
+// if the word is not present.
+func removeWord(list []Word, word string) ([]Word, error) {
+	i, found := slices.BinarySearchFunc(list, word, func(w Word, word string) int {
+		return strings.Compare(w.word, word)
+	})
+
+	if !found {
+		return nil, fmt.Errorf("'%s': %w", word, symbols.ErrNotFound)
+	}
+
+	return slices.Delete(list, i, i+1), nil
+}
+
+// sameForms returns true if a and b have the same FormType
+// and irregular forms.
+func sameForms(a, b Word) bool {
+	if a.ft != b.ft {
+		return false
+	}
+
+	if a.irr == nil || b.irr == nil {
+		return a.irr == b.irr
+	}
+
+	return slices.Equal(*a.irr, *b.irr)
+}
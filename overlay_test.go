@@ -0,0 +1,88 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests whether DefaultGeneratorWithOverlay adds and removes words, keeps
+// the lists sorted and rejects conflicting or missing entries.
+func TestDefaultGeneratorWithOverlay(t *testing.T) {
+	quokka, _ := NewWord("0quokka")
+	neng, _ := NewWord("5neng")
+	big, _ := NewWord("3big")
+	bigIrr, _ := NewWord("1big,bigger,biggest")
+	ownSuf, _ := NewWord("3own")
+
+	gen, err := DefaultGeneratorWithOverlay(map[WordClass]Overlay{
+		WC_ADJECTIVE: {Add: []Word{big, ownSuf}, Remove: []string{"own"}},
+		WC_NOUN:      {Add: []Word{neng, quokka, quokka}, Remove: []string{"muffin"}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGeneratorWithOverlay returned an error: %v", err)
+	}
+
+	def, _ := DefaultGenerator(nil)
+
+	for wc := WC_ADJECTIVE; wc <= WC_VERB; wc++ {
+		list, _ := gen.getList(wc)
+		if !slices.IsSortedFunc(list, cmpWord) {
+			t.Errorf("Failed for WordClass %s: list not sorted", wc)
+		}
+	}
+
+	if n, _ := gen.Len(WC_NOUN); n != len(def.lists.Load()[WC_NOUN])+1 {
+		t.Errorf("Failed: expected %d nouns, got %d", len(def.lists.Load()[WC_NOUN])+1, n)
+	}
+
+	if w, err := gen.Find("quokka", WC_NOUN); err != nil || w.ft != FT_REGULAR {
+		t.Errorf("Failed: added word not found: %v", err)
+	}
+
+	if w, err := gen.Find("own", WC_ADJECTIVE); err != nil || w.ft != FT_SUFFIXED {
+		t.Errorf("Failed: replaced word not found or has incorrect FormType: %v", err)
+	}
+
+	if _, err := gen.Find("muffin", WC_NOUN); err == nil {
+		t.Error("Failed: removed word was found")
+	}
+
+	if _, err := def.Find("muffin", WC_NOUN); err != nil {
+		t.Error("Failed: removal affected other Generators")
+	}
+
+	errCases := []struct {
+		overlays map[WordClass]Overlay
+		err      error
+	}{
+		{map[WordClass]Overlay{WC_ADJECTIVE: {Add: []Word{bigIrr}}}, symbols.ErrConflictingWord},
+		{map[WordClass]Overlay{WC_NOUN: {Remove: []string{"quokka"}}}, symbols.ErrNotFound},
+		{map[WordClass]Overlay{WordClass(255): {Add: []Word{quokka}}}, symbols.ErrUndefinedWordClass},
+	}
+
+	for i, c := range errCases {
+		if _, err := DefaultGeneratorWithOverlay(c.overlays, nil); !errors.Is(err, c.err) {
+			t.Errorf("Failed for errCase %d: expected '%v', got '%v'", i, c.err, err)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"strings"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Homophones selects how Generator treats words that sound alike.
+type Homophones uint8
+
+const (
+	// Words are drawn regardless of their pronunciation (default).
+	HP_ALLOW Homophones = iota
+
+	// Words within a single phrase generated with Generator.Phrase
+	// are phonetically distinct - a phrase never contains both 'night'
+	// and 'knight'.
+	HP_DISTINCT
+
+	// Like HP_DISTINCT, but words that have a homophone in any
+	// of the Generator's lists are never drawn, so that the generated
+	// names are unambiguous when dictated. The comparison of spellings
+	// is approximate - with the default lists, it excludes about 8%
+	// of nouns, 17% of verbs and 4% of adjectives.
+	HP_EXCLUDE
+)
+
+// homophoneIndex maps the keys returned by homophoneKey to the number of distinct spellings
+// sharing the key across the word lists.
+type homophoneIndex map[string]int
+
+// Phonetic returns the phonetic key of the Word, computed with
+// the Metaphone algorithm. Words that sound alike share the key
+// (night, knight: "NT"). Characters other than letters are ignored.
+// Metaphone keeps only the initial vowel, so the key is also shared
+// by many words that sound different (cat, kit: "KT"). The homophone
+// policies compare the vowels as well.
+func (w Word) Phonetic() string {
+	return metaphone(w.word)
+}
+
+// Homophones returns the homophone policy of the Generator.
+func (gen *Generator) Homophones() Homophones {
+	return Homophones(gen.homophones.Load())
+}
+
+// SetHomophones sets the homophone policy of the Generator. Returns
+// symbols.ErrUndefinedHomophones if hp is an undefined value.
+func (gen *Generator) SetHomophones(hp Homophones) error {
+	if hp > HP_EXCLUDE {
+		return symbols.ErrUndefinedHomophones
+	}
+
+	gen.homophones.Store(uint32(hp))
+	return nil
+}
+
+// homophoneIndex returns the index of phonetic keys built from the current
+// snapshot of the word lists.
+func (gen *Generator) homophoneIndex() homophoneIndex {
+	return gen.phonetic.get(gen.lists.Load(), func(lists *wordLists) homophoneIndex {
+		spellings := make(map[string]map[string]struct{})
+
+		for _, list := range lists.listed() {
+			for _, w := range list {
+				key := homophoneKey(w.word)
+				if spellings[key] == nil {
+					spellings[key] = make(map[string]struct{})
+				}
+				spellings[key][strings.ToLower(w.word)] = struct{}{}
+			}
+		}
+
+		index := make(homophoneIndex, len(spellings))
+		for key, set := range spellings {
+			index[key] = len(set)
+		}
+		return index
+	})
+}
+
+// homophoneKey returns the key used by the homophone policies. It extends
+// the Metaphone key of s, which keeps only the initial vowel, with a coarse
+// transcription of the vowels, so that 'cat' and 'kit' or 'bat' and 'boat'
+// are told apart, while 'night' and 'knight' or 'write' and 'right' still
+// share the key.
+func homophoneKey(s string) string {
+	return metaphone(s) + "/" + vowelKey(s)
+}
+
+// longVowels maps spellings of long vowels and diphthongs to their
+// symbols in vowelKey.
+var longVowels = map[string]byte{
+	"ae": 'A', "ai": 'A', "ay": 'A', "ei": 'A', "ey": 'A',
+	"ea": 'E', "ee": 'E', "ie": 'E',
+	"oa": 'O', "oe": 'O', "ow": 'O',
+	"ew": 'U', "oo": 'U', "ue": 'U', "ui": 'U',
+	"au": 'C', "aw": 'C',
+	"oi": 'Y', "oy": 'Y',
+	"ou": 'W',
+}
+
+// vowelKey returns a coarse transcription of the vowels of s. Every group
+// of vowel letters is written as a single symbol: short vowels in lower
+// case and long vowels in upper case. A vowel is long if it is spelled
+// with a digraph (see longVowels), followed by 'gh' (night) or by a single
+// consonant and a silent final 'e' (write). Characters other than letters
+// are ignored.
+func vowelKey(s string) string {
+	w := make([]byte, 0, len(s))
+	for i := range len(s) {
+		switch c := s[i]; true {
+		case c >= 'a' && c <= 'z':
+			w = append(w, c)
+		case c >= 'A' && c <= 'Z':
+			w = append(w, c+'a'-'A')
+		}
+	}
+
+	// Silent final 'e' lengthens the preceding vowel (rate, rite)
+	silentE := len(w) > 2 && w[len(w)-1] == 'e' && strings.IndexByte("aeiouy", w[len(w)-2]) == -1
+	if silentE {
+		w = w[:len(w)-1]
+	}
+
+	isVowel := func(i int) bool {
+		switch w[i] {
+		case 'a', 'e', 'i', 'o', 'u':
+			return true
+		case 'w':
+			// Part of a vowel group (law, new)
+			return i > 0 && strings.IndexByte("aeiou", w[i-1]) != -1
+		case 'y':
+			// Vowel, unless it begins the word (yes)
+			return i > 0
+		}
+		return false
+	}
+
+	key := make([]byte, 0, 4)
+
+	for i := 0; i < len(w); {
+		if !isVowel(i) {
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(w) && isVowel(i) {
+			i++
+		}
+		group := string(w[start:i])
+
+		var sym byte
+		if long, ok := longVowels[group[:min(2, len(group))]]; ok {
+			sym = long
+		} else {
+			sym = group[0]
+			if sym == 'y' {
+				sym = 'i'
+			}
+			if strings.HasPrefix(string(w[i:]), "gh") || silentE && i == len(w)-1 {
+				sym -= 'a' - 'A'
+			}
+		}
+
+		key = append(key, sym)
+	}
+
+	return string(key)
+}
+
+// metaphone returns the Metaphone key of s. The initial vowels are
+// encoded as 'A', '0' stands for 'th' and 'X' for 'sh'.
+func metaphone(s string) string {
+	w := make([]byte, 0, len(s))
+	for i := range len(s) {
+		switch c := s[i]; true {
+		case c >= 'a' && c <= 'z':
+			w = append(w, c)
+		case c >= 'A' && c <= 'Z':
+			w = append(w, c+'a'-'A')
+		}
+	}
+
+	if len(w) == 0 {
+		return ""
+	}
+
+	// Silent initial letters
+	switch string(w[:min(2, len(w))]) {
+	case "ae", "gn", "kn", "pn", "wr":
+		w = w[1:]
+	case "wh":
+		w = append([]byte{'w'}, w[2:]...)
+	}
+
+	if w[0] == 'x' {
+		w[0] = 's'
+	}
+
+	// at returns the letter at i or 0 if i is out of bounds
+	at := func(i int) byte {
+		if i < 0 || i >= len(w) {
+			return 0
+		}
+		return w[i]
+	}
+
+	isVowel := func(c byte) bool {
+		return c != 0 && strings.IndexByte("aeiou", c) != -1
+	}
+
+	var key strings.Builder
+
+	for i, c := range w {
+		if c != 'c' && c == at(i-1) {
+			continue
+		}
+
+		next := at(i + 1)
+
+		switch c {
+		case 'a', 'e', 'i', 'o', 'u':
+			if i == 0 {
+				key.WriteByte('A')
+			}
+		case 'b':
+			if !(i == len(w)-1 && at(i-1) == 'm') {
+				key.WriteByte('B')
+			}
+		case 'c':
+			switch true {
+			case next == 'i' && at(i+2) == 'a', next == 'h' && at(i-1) != 's':
+				key.WriteByte('X')
+			case strings.IndexByte("iey", next) != -1:
+				if at(i-1) != 's' {
+					key.WriteByte('S')
+				}
+			default:
+				key.WriteByte('K')
+			}
+		case 'd':
+			if next == 'g' && strings.IndexByte("iey", at(i+2)) != -1 {
+				key.WriteByte('J')
+			} else {
+				key.WriteByte('T')
+			}
+		case 'g':
+			switch true {
+			case next == 'h' && !isVowel(at(i+2)):
+				// Silent in 'night', 'high'
+			case next == 'n' && (i+2 == len(w) || string(w[i+1:]) == "ned"):
+				// Silent in 'sign', 'signed'
+			case at(i-1) == 'd' && strings.IndexByte("iey", next) != -1:
+				// Encoded by 'd' in 'judge'
+			case strings.IndexByte("iey", next) != -1 && at(i-1) != 'g':
+				key.WriteByte('J')
+			default:
+				key.WriteByte('K')
+			}
+		case 'h':
+			prev := at(i - 1)
+			if strings.IndexByte("cgpst", prev) == -1 && !(isVowel(prev) && !isVowel(next)) {
+				key.WriteByte('H')
+			}
+		case 'k':
+			if at(i-1) != 'c' {
+				key.WriteByte('K')
+			}
+		case 'p':
+			if next == 'h' {
+				key.WriteByte('F')
+			} else {
+				key.WriteByte('P')
+			}
+		case 'q':
+			key.WriteByte('K')
+		case 's':
+			if next == 'h' || (next == 'i' && (at(i+2) == 'o' || at(i+2) == 'a')) {
+				key.WriteByte('X')
+			} else {
+				key.WriteByte('S')
+			}
+		case 't':
+			switch true {
+			case next == 'i' && (at(i+2) == 'o' || at(i+2) == 'a'):
+				key.WriteByte('X')
+			case next == 'h':
+				key.WriteByte('0')
+			case next == 'c' && at(i+2) == 'h':
+				// Silent in 'watch'
+			default:
+				key.WriteByte('T')
+			}
+		case 'v':
+			key.WriteByte('F')
+		case 'w', 'y':
+			if isVowel(next) {
+				key.WriteByte(c - 'a' + 'A')
+			}
+		case 'x':
+			key.WriteString("KS")
+		case 'z':
+			key.WriteByte('S')
+		default:
+			key.WriteByte(c - 'a' + 'A')
+		}
+	}
+
+	return key.String()
+}
@@ -0,0 +1,146 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests metaphone. Fails if a word is encoded incorrectly.
+func TestMetaphone(t *testing.T) {
+	cases := map[string]string{
+		"night":         "NT",
+		"knight":        "NT",
+		"write":         "RT",
+		"right":         "RT",
+		"thumb":         "0M",
+		"ghost":         "KST",
+		"sign":          "SN",
+		"signed":        "SNT",
+		"phone":         "FN",
+		"school":        "SKL",
+		"science":       "SNS",
+		"xerox":         "SRKS",
+		"which":         "WX",
+		"watch":         "WX",
+		"nation":        "NXN",
+		"judge":         "JJ",
+		"eight":         "AT",
+		"ate":           "AT",
+		"aerial":        "ARL",
+		"pneumonia":     "NMN",
+		"mother-in-law": "M0RNL",
+		"look up":       "LKP",
+		"":              "",
+	}
+
+	for input, expected := range cases {
+		if output := metaphone(input); output != expected {
+			t.Errorf("Failed for '%s': expected '%s', got '%s'", input, expected, output)
+		}
+	}
+}
+
+// Tests homophoneKey. Fails if a pair of homophones receives different
+// keys or a pair of words that merely share the Metaphone key receives
+// the same one.
+func TestHomophoneKey(t *testing.T) {
+	same := [][2]string{
+		{"night", "knight"},
+		{"write", "right"},
+		{"eight", "ate"},
+		{"see", "sea"},
+		{"plain", "plane"},
+		{"rain", "reign"},
+		{"meat", "meet"},
+		{"road", "rode"},
+		{"tail", "tale"},
+	}
+
+	for _, c := range same {
+		if a, b := homophoneKey(c[0]), homophoneKey(c[1]); a != b {
+			t.Errorf("Failed: '%s' (%s) and '%s' (%s) receive different keys", c[0], a, c[1], b)
+		}
+	}
+
+	different := [][2]string{
+		{"cat", "kit"},
+		{"bat", "boat"},
+		{"rat", "rate"},
+		{"bit", "bite"},
+		{"cot", "coat"},
+		{"day", "to"},
+	}
+
+	for _, c := range different {
+		if a, b := homophoneKey(c[0]), homophoneKey(c[1]); a == b {
+			t.Errorf("Failed: '%s' and '%s' share the key %s", c[0], c[1], a)
+		}
+	}
+}
+
+// Tests Generator.SetHomophones. Fails if HP_DISTINCT allows
+// a homophone of another word within a phrase, HP_EXCLUDE draws a word
+// that has a homophone or an undefined value is accepted.
+func TestGenerator_SetHomophones(t *testing.T) {
+	gen, err := NewGenerator([]string{"3big"}, []string{"0nicely"}, []string{"0knight", "0night", "0zebra"}, []string{"0stash"}, DEFAULT_ITER_LIMIT, false, nil)
+	if err != nil {
+		t.Fatalf("Failed: NewGenerator returned an error: %v", err)
+	}
+
+	if err := gen.SetHomophones(HP_DISTINCT); err != nil {
+		t.Fatalf("Failed: SetHomophones returned an error: %v", err)
+	}
+
+	for range 100 {
+		phrase, err := gen.Phrase("%n %n")
+		if err != nil {
+			t.Fatalf("Failed: Phrase returned an error: %v", err)
+		}
+
+		if words := strings.Fields(phrase); homophoneKey(words[0]) == homophoneKey(words[1]) {
+			t.Fatalf("Failed for HP_DISTINCT: homophones in '%s'", phrase)
+		}
+	}
+
+	if _, err := gen.Phrase("%n %n %n %n"); !errors.Is(err, symbols.ErrIterLimit) {
+		t.Errorf("Failed for HP_DISTINCT: expected ErrIterLimit for exhausted list, got %v", err)
+	}
+
+	if err := gen.SetHomophones(HP_EXCLUDE); err != nil {
+		t.Fatalf("Failed: SetHomophones returned an error: %v", err)
+	}
+
+	for range 100 {
+		if n, err := gen.Noun(MOD_NONE); err != nil || n != "zebra" {
+			t.Fatalf("Failed for HP_EXCLUDE: expected 'zebra', got '%s' (error: %v)", n, err)
+		}
+	}
+
+	if err := gen.SetHomophones(HP_EXCLUDE + 1); !errors.Is(err, symbols.ErrUndefinedHomophones) {
+		t.Errorf("Failed: expected ErrUndefinedHomophones, got %v", err)
+	}
+
+	if hp := gen.Homophones(); hp != HP_EXCLUDE {
+		t.Errorf("Failed: expected HP_EXCLUDE, got %d", hp)
+	}
+}
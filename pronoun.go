@@ -0,0 +1,44 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import "strings"
+
+// objectiveCases maps pronouns to their objective case forms. Pronouns
+// that are not present in the map have identical subjective and objective
+// forms. The map covers English pronouns regardless of whether they are
+// present in the embedded list, so that pronouns added by the user
+// are transformed as well.
+var objectiveCases = map[string]string{
+	"he":      "him",
+	"i":       "me",
+	"she":     "her",
+	"they":    "them",
+	"we":      "us",
+	"who":     "whom",
+	"whoever": "whomever",
+}
+
+// objective returns the objective case of a pronoun. The lookup is
+// case-insensitive, so that "I" yields "me".
+func objective(pronoun string) string {
+	if o, ok := objectiveCases[strings.ToLower(pronoun)]; ok {
+		return o
+	}
+	return pronoun
+}
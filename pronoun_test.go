@@ -0,0 +1,86 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests objective. Fails if an incorrect objective case of a pronoun
+// is returned.
+func TestObjective(t *testing.T) {
+	cases := map[string]string{
+		"he":      "him",
+		"i":       "me",
+		"I":       "me",
+		"she":     "her",
+		"they":    "them",
+		"we":      "us",
+		"who":     "whom",
+		"whoever": "whomever",
+		"it":      "it",
+		"you":     "you",
+		"someone": "someone",
+	}
+
+	for input, expected := range cases {
+		if output := objective(input); output != expected {
+			t.Errorf("Failed for '%s': expected '%s', got '%s'", input, expected, output)
+		}
+	}
+}
+
+// Tests whether Generator.Pronoun picks pronouns of the requested number
+// and whether Generator.TransformWord rejects singular pronouns
+// with MOD_PLURAL.
+func TestGenerator_Pronoun(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	for _, plural := range []bool{false, true} {
+		var mods Mod
+		if plural {
+			mods = MOD_PLURAL
+		}
+
+		for range 100 {
+			p, err := gen.Pronoun(mods)
+			if err != nil {
+				t.Fatalf("Failed: error returned: %v", err)
+			}
+
+			w, err := gen.Find(p, WC_PRONOUN)
+			if err != nil {
+				t.Fatalf("Failed: '%s' not found: %v", p, err)
+			}
+
+			if (w.ft == FT_PLURAL_ONLY) != plural {
+				t.Errorf("Failed: '%s' picked for plural = %v", p, plural)
+			}
+		}
+	}
+
+	if _, err := gen.Transform("she", WC_PRONOUN, MOD_PLURAL); !errors.Is(err, symbols.ErrSingularOnly) {
+		t.Errorf("Failed: expected ErrSingularOnly, got %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"github.com/Zedran/neng/internal/phon"
+	"github.com/Zedran/neng/symbols"
+)
+
+// Pronounceability estimates how easy the Word is to pronounce and read
+// on a scale from 1 (hard) to 100 (easy). The score is lowered by long
+// consonant clusters and vowel runs, excessive length and syllable count,
+// and characters other than letters, which mark multi-word collocations
+// (look up) and hyphenated compounds. The estimate is based on spelling,
+// so it is only a rough approximation.
+func (w Word) Pronounceability() int {
+	return phon.Score(w.word)
+}
+
+// MinPronounceability returns the minimum pronounceability score
+// of the words drawn by the Generator.
+func (gen *Generator) MinPronounceability() int {
+	return int(gen.minScore.Load())
+}
+
+// SetMinPronounceability restricts the words drawn by the Generator to those
+// whose Word.Pronounceability is at least score. 0 disables the restriction.
+// Words provided by the caller, e.g. to Generator.TransformWord, are not
+// affected. Returns symbols.ErrBadScore if score lies outside 0-100.
+//
+// A high threshold excludes most words of some lists, in which case
+// the generating methods may return symbols.ErrIterLimit.
+func (gen *Generator) SetMinPronounceability(score int) error {
+	if score < 0 || score > 100 {
+		return symbols.ErrBadScore
+	}
+
+	gen.minScore.Store(uint32(score))
+	return nil
+}
+
+// scoreIndex maps the words of the Generator's lists to their
+// pronounceability scores.
+type scoreIndex map[string]uint8
+
+// scoreIndex returns the pronounceability scores of the words from
+// the current snapshot of the word lists, built once per snapshot.
+// The scores of the embedded words are taken from the embedded data,
+// only those of the words added by the caller are computed.
+func (gen *Generator) scoreIndex() scoreIndex {
+	return gen.scores.get(gen.lists.Load(), func(lists *wordLists) scoreIndex {
+		// Unreadable embedded scores are computed like any other
+		embedded, _ := embeddedScores()
+
+		var n int
+		for _, list := range lists {
+			n += len(list)
+		}
+
+		index := make(scoreIndex, n)
+		for _, list := range lists {
+			for _, w := range list {
+				if score, ok := embedded[w.word]; ok {
+					index[w.word] = score
+				} else {
+					index[w.word] = uint8(w.Pronounceability())
+				}
+			}
+		}
+		return index
+	})
+}
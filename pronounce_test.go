@@ -0,0 +1,117 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests Word.Pronounceability. Fails if a word that is hard to pronounce
+// does not score lower than an easy one.
+func TestWord_Pronounceability(t *testing.T) {
+	cases := []struct {
+		easy, hard string
+	}{
+		{"otter", "aalii"},
+		{"brave", "strengths"},
+		{"banana", "nth"},
+		{"lookout", "look up"},
+		{"beautiful", "antidisestablishmentarianism"},
+		{"rhythm", "tsk"},
+	}
+
+	for _, c := range cases {
+		easy, _ := NewWord("0" + c.easy)
+		hard, _ := NewWord("0" + c.hard)
+
+		if e, h := easy.Pronounceability(), hard.Pronounceability(); e <= h {
+			t.Errorf("Failed: '%s' (%d) does not score higher than '%s' (%d)", c.easy, e, c.hard, h)
+		}
+	}
+
+	for _, s := range []string{"", "a", "-", "antidisestablishmentarianism"} {
+		w := Word{word: s}
+		if score := w.Pronounceability(); score < 1 || score > 100 {
+			t.Errorf("Failed for '%s': score %d outside 1-100", s, score)
+		}
+	}
+}
+
+// Tests embeddedScores. Fails if the scores cannot be read, if any word
+// of the embedded lists lacks a score or if a stored score differs from
+// Word.Pronounceability.
+func TestEmbeddedScores(t *testing.T) {
+	scores, err := embeddedScores()
+	if err != nil {
+		t.Fatalf("Failed: embeddedScores returned an error: %v", err)
+	}
+
+	for _, lang := range []Language{English{}, Esperanto{}} {
+		gen, err := DefaultGeneratorForLanguage(lang, nil)
+		if err != nil {
+			t.Fatalf("Failed: DefaultGeneratorForLanguage returned an error: %v", err)
+		}
+
+		for wc, list := range gen.lists.Load().listed() {
+			for _, w := range list {
+				score, ok := scores[w.word]
+				if !ok {
+					t.Errorf("Failed for '%s' (%s): no embedded score", w.word, wc)
+				} else if int(score) != w.Pronounceability() {
+					t.Errorf("Failed for '%s' (%s): embedded score %d, computed %d", w.word, wc, score, w.Pronounceability())
+				}
+			}
+		}
+	}
+}
+
+// Tests Generator.SetMinPronounceability. Fails if a word scoring below
+// the threshold is drawn or an invalid score is accepted.
+func TestGenerator_SetMinPronounceability(t *testing.T) {
+	gen, err := NewGenerator([]string{"0aalii", "3big"}, []string{"0nicely"}, []string{"0nth", "0otter"}, []string{"0stash"}, DEFAULT_ITER_LIMIT, false, nil)
+	if err != nil {
+		t.Fatalf("Failed: NewGenerator returned an error: %v", err)
+	}
+
+	if err := gen.SetMinPronounceability(90); err != nil {
+		t.Fatalf("Failed: SetMinPronounceability returned an error: %v", err)
+	}
+
+	for range 100 {
+		phrase, err := gen.Phrase("%a %n")
+		if err != nil {
+			t.Fatalf("Failed: Phrase returned an error: %v", err)
+		}
+		if phrase != "big otter" {
+			t.Fatalf("Failed: expected 'big otter', got '%s'", phrase)
+		}
+	}
+
+	for _, score := range []int{-1, 101} {
+		if err := gen.SetMinPronounceability(score); !errors.Is(err, symbols.ErrBadScore) {
+			t.Errorf("Failed for %d: expected ErrBadScore, got %v", score, err)
+		}
+	}
+
+	if score := gen.MinPronounceability(); score != 90 {
+		t.Errorf("Failed: expected 90, got %d", score)
+	}
+}
@@ -0,0 +1,174 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// SearchOptions holds the settings of Generator.Search.
+type SearchOptions struct {
+	// Include words beginning with the query (autocomplete)
+	Prefix bool
+
+	// Include words within MaxDistance edits (Levenshtein distance)
+	// of the query. 0 disables fuzzy matching.
+	MaxDistance int
+
+	// Maximum number of returned words. 0 returns all matches.
+	Limit int
+}
+
+// searchIndex holds BK-trees of the words from a snapshot of word lists.
+// A tree is only built when a list is searched for the first time.
+type searchIndex [wc_undefined]func() *bkTree
+
+// match is a single result of Generator.Search.
+type match struct {
+	word Word
+
+	// 0 - exact match, 1 - prefix match, 2 - fuzzy match
+	kind int
+
+	// Edit distance for fuzzy matches, length for prefix matches
+	rank int
+}
+
+// Search looks up words similar to query in the list corresponding to wc
+// and returns them ranked from the best match: the word equal to query
+// goes first, followed by the words beginning with query (if opts.Prefix
+// is true), shortest first, and the words within opts.MaxDistance edits
+// of query, closest first. Ties are broken alphabetically. The comparison
+// is not case sensitive.
+//
+// Fuzzy matching is backed by a BK-tree, which is built once for each list
+// and rebuilt only after the list is modified.
+//
+// Returns an error if:
+//   - undefined WordClass value or WC_PLACE is specified
+//   - opts.MaxDistance or opts.Limit is negative
+func (gen *Generator) Search(query string, wc WordClass, opts SearchOptions) ([]Word, error) {
+	if err := checkListed(wc); err != nil {
+		return nil, err
+	}
+
+	switch true {
+	case opts.MaxDistance < 0:
+		return nil, symbols.ErrBadDistance
+	case opts.Limit < 0:
+		return nil, symbols.ErrBadLimit
+	}
+
+	lists := gen.lists.Load()
+	list := lists[wc]
+	query = strings.ToLower(query)
+
+	found := make(map[string]match)
+
+	add := func(m match) {
+		if old, ok := found[m.word.word]; !ok || cmpMatch(m, old) < 0 {
+			found[m.word.word] = m
+		}
+	}
+
+	start, _ := slices.BinarySearchFunc(list, query, func(w Word, q string) int {
+		return strings.Compare(w.word, q)
+	})
+
+	for _, w := range list[start:] {
+		if w.word == query {
+			add(match{word: w})
+			continue
+		}
+		if !opts.Prefix || !strings.HasPrefix(w.word, query) {
+			break
+		}
+		add(match{word: w, kind: 1, rank: len(w.word)})
+	}
+
+	if opts.MaxDistance > 0 {
+		tree := gen.search.get(lists, newSearchIndex)[wc]()
+
+		tree.within(query, opts.MaxDistance, func(s string, d int) bool {
+			if i, ok := slices.BinarySearchFunc(list, s, func(w Word, s string) int {
+				return strings.Compare(w.word, s)
+			}); ok {
+				add(match{word: list[i], kind: min(d, 1) * 2, rank: d})
+			}
+			return true
+		})
+	}
+
+	matches := make([]match, 0, len(found))
+	for _, m := range found {
+		matches = append(matches, m)
+	}
+	slices.SortFunc(matches, cmpMatch)
+
+	if opts.Limit > 0 && len(matches) > opts.Limit {
+		matches = matches[:opts.Limit]
+	}
+
+	words := make([]Word, len(matches))
+	for i, m := range matches {
+		words[i] = m.word
+	}
+
+	return words, nil
+}
+
+// Suggest returns up to 5 words from the list corresponding to wc that are
+// at most 2 edits away from word, closest first. It is intended to offer
+// alternatives when Generator.Find returns symbols.ErrNotFound. Returns
+// an error if undefined WordClass value or WC_PLACE is specified.
+func (gen *Generator) Suggest(word string, wc WordClass) ([]Word, error) {
+	return gen.Search(word, wc, SearchOptions{MaxDistance: 2, Limit: 5})
+}
+
+// cmpMatch is a comparison function for sorting the results
+// of Generator.Search.
+func cmpMatch(a, b match) int {
+	return cmp.Or(cmp.Compare(a.kind, b.kind), cmp.Compare(a.rank, b.rank), strings.Compare(a.word.word, b.word.word))
+}
+
+// levenshtein returns the Levenshtein distance between a and b.
+func levenshtein(a, b []rune) int {
+	return editDistance(a, b, 1, false)
+}
+
+// newSearchIndex returns a searchIndex for lists.
+func newSearchIndex(lists *wordLists) *searchIndex {
+	var idx searchIndex
+
+	for wc, list := range lists {
+		idx[wc] = sync.OnceValue(func() *bkTree {
+			tree := newBKTree(levenshtein)
+			for _, w := range list {
+				tree.add(w.word)
+			}
+			return tree
+		})
+	}
+
+	return &idx
+}
@@ -0,0 +1,111 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests Generator.Search. Fails if the returned words are not ranked
+// as documented, the index is not rebuilt after a list is modified
+// or invalid arguments are accepted.
+func TestGenerator_Search(t *testing.T) {
+	gen, err := NewGenerator([]string{"3big"}, []string{"0nicely"}, []string{"0bat", "0bath", "0bathtub", "0bats", "0cat", "0hat", "0otter"}, []string{"0stash"}, DEFAULT_ITER_LIMIT, false, nil)
+	if err != nil {
+		t.Fatalf("Failed: NewGenerator returned an error: %v", err)
+	}
+
+	cases := []struct {
+		query    string
+		opts     SearchOptions
+		expected []string
+	}{
+		{"bat", SearchOptions{}, []string{"bat"}},
+		{"Bat", SearchOptions{}, []string{"bat"}},
+		{"bat", SearchOptions{Prefix: true}, []string{"bat", "bath", "bats", "bathtub"}},
+		{"bat", SearchOptions{MaxDistance: 1}, []string{"bat", "bath", "bats", "cat", "hat"}},
+		{"bat", SearchOptions{Prefix: true, MaxDistance: 1}, []string{"bat", "bath", "bats", "bathtub", "cat", "hat"}},
+		{"bat", SearchOptions{Prefix: true, MaxDistance: 1, Limit: 2}, []string{"bat", "bath"}},
+		{"oter", SearchOptions{MaxDistance: 1}, []string{"otter"}},
+		{"oter", SearchOptions{Prefix: true}, []string{}},
+		{"zzz", SearchOptions{Prefix: true, MaxDistance: 2}, []string{}},
+	}
+
+	for _, c := range cases {
+		words, err := gen.Search(c.query, WC_NOUN, c.opts)
+		if err != nil {
+			t.Errorf("Failed for '%s' %v: error returned: %v", c.query, c.opts, err)
+			continue
+		}
+
+		output := make([]string, len(words))
+		for i, w := range words {
+			output[i] = w.word
+		}
+
+		if !slices.Equal(output, c.expected) {
+			t.Errorf("Failed for '%s' %v: expected %v, got %v", c.query, c.opts, c.expected, output)
+		}
+	}
+
+	if err := gen.AddWord(Word{word: "rat"}, WC_NOUN); err != nil {
+		t.Fatalf("Failed: AddWord returned an error: %v", err)
+	}
+
+	if words, _ := gen.Search("rat", WC_NOUN, SearchOptions{MaxDistance: 1}); len(words) == 0 || words[0].word != "rat" {
+		t.Errorf("Failed: added word not found, got %v", words)
+	}
+
+	errCases := []struct {
+		wc       WordClass
+		opts     SearchOptions
+		expected error
+	}{
+		{wc_undefined, SearchOptions{}, symbols.ErrUndefinedWordClass},
+		{WC_NOUN, SearchOptions{MaxDistance: -1}, symbols.ErrBadDistance},
+		{WC_NOUN, SearchOptions{Limit: -1}, symbols.ErrBadLimit},
+	}
+
+	for _, c := range errCases {
+		if _, err := gen.Search("bat", c.wc, c.opts); !errors.Is(err, c.expected) {
+			t.Errorf("Failed for %d, %v: expected '%v', got '%v'", c.wc, c.opts, c.expected, err)
+		}
+	}
+}
+
+// Tests Generator.Suggest. Fails if a close word from the embedded list
+// is not suggested for a misspelled one.
+func TestGenerator_Suggest(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	words, err := gen.Suggest("elephnt", WC_NOUN)
+	if err != nil {
+		t.Fatalf("Failed: Suggest returned an error: %v", err)
+	}
+
+	if len(words) == 0 || len(words) > 5 || words[0].word != "elephant" {
+		t.Errorf("Failed: expected 'elephant' first, got %v", words)
+	}
+}
@@ -0,0 +1,160 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"strings"
+	"sync"
+)
+
+// Spelling selects the spelling variant of words returned by the Generator.
+type Spelling uint8
+
+const (
+	// Words are spelled as in the word lists, which mostly follow American
+	// spelling (color, organize), while the inflection doubles the final
+	// 'l' of verbs (travelled, cancelled).
+	SP_DEFAULT Spelling = iota
+
+	// American spelling: color, organize, gray, traveled, fueling.
+	SP_AMERICAN
+
+	// British spelling: colour, organise, grey, travelled, fuelling.
+	SP_BRITISH
+)
+
+// britishVariants maps American spellings of base words to their British
+// counterparts. Words not found in the table are spelled with British
+// suffixes (-ise, -isation, -yse) by britishSuffix.
+var britishVariants = map[string]string{
+	"aluminum":  "aluminium",
+	"analog":    "analogue",
+	"ardor":     "ardour",
+	"armor":     "armour",
+	"artifact":  "artefact",
+	"behavior":  "behaviour",
+	"caliber":   "calibre",
+	"candor":    "candour",
+	"catalog":   "catalogue",
+	"center":    "centre",
+	"clamor":    "clamour",
+	"color":     "colour",
+	"cozy":      "cosy",
+	"defense":   "defence",
+	"dialog":    "dialogue",
+	"endeavor":  "endeavour",
+	"favor":     "favour",
+	"favorite":  "favourite",
+	"fervor":    "fervour",
+	"fiber":     "fibre",
+	"flavor":    "flavour",
+	"gray":      "grey",
+	"harbor":    "harbour",
+	"honor":     "honour",
+	"humor":     "humour",
+	"jewelry":   "jewellery",
+	"labor":     "labour",
+	"liter":     "litre",
+	"luster":    "lustre",
+	"maneuver":  "manoeuvre",
+	"mold":      "mould",
+	"molt":      "moult",
+	"mustache":  "moustache",
+	"neighbor":  "neighbour",
+	"odor":      "odour",
+	"offense":   "offence",
+	"pajamas":   "pyjamas",
+	"parlor":    "parlour",
+	"plow":      "plough",
+	"pretense":  "pretence",
+	"rancor":    "rancour",
+	"rigor":     "rigour",
+	"rumor":     "rumour",
+	"saber":     "sabre",
+	"savior":    "saviour",
+	"skeptic":   "sceptic",
+	"skeptical": "sceptical",
+	"smolder":   "smoulder",
+	"somber":    "sombre",
+	"specter":   "spectre",
+	"splendor":  "splendour",
+	"theater":   "theatre",
+	"tumor":     "tumour",
+	"valor":     "valour",
+	"vapor":     "vapour",
+	"vigor":     "vigour",
+}
+
+// americanVariants maps British spellings found in the word lists (grey)
+// to their American counterparts. It is the reverse of britishVariants.
+var americanVariants = sync.OnceValue(func() map[string]string {
+	m := make(map[string]string, len(britishVariants))
+	for am, br := range britishVariants {
+		m[br] = am
+	}
+	return m
+})
+
+// britishSuffixes holds American suffixes and their British counterparts,
+// ordered so that longer suffixes are tested first.
+var britishSuffixes = [][2]string{
+	{"ization", "isation"},
+	{"izing", "ising"},
+	{"izer", "iser"},
+	{"ized", "ised"},
+	{"ize", "ise"},
+	{"yze", "yse"},
+}
+
+// britishSuffix replaces the American suffix of s with its British
+// counterpart (organize -> organise). Words in which '-ize' is not
+// a suffix (size, prize, seize) are returned unchanged.
+func britishSuffix(s string) string {
+	for _, suf := range britishSuffixes {
+		root, found := strings.CutSuffix(s, suf[0])
+		if !found {
+			continue
+		}
+
+		if suf[0][0] == 'i' && (len(root) < 3 || strings.HasSuffix(root, "s") || strings.ContainsRune("aeiou", rune(root[len(root)-1]))) {
+			return s
+		}
+
+		return root + suf[1]
+	}
+
+	return s
+}
+
+// spell returns word spelled according to sp. Irregular forms
+// of the word are not respelled.
+func (sp Spelling) spell(word Word) Word {
+	switch sp {
+	case SP_AMERICAN:
+		if am, ok := americanVariants()[word.word]; ok {
+			word.word = am
+		}
+	case SP_BRITISH:
+		if br, ok := britishVariants[word.word]; ok {
+			word.word = br
+		} else {
+			word.word = britishSuffix(word.word)
+		}
+	}
+	return word
+}
@@ -0,0 +1,165 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests britishSuffix. Fails if an American suffix is not replaced
+// or a word in which '-ize' is not a suffix is modified.
+func TestBritishSuffix(t *testing.T) {
+	cases := map[string]string{
+		"organize":     "organise",
+		"organization": "organisation",
+		"organizer":    "organiser",
+		"civilized":    "civilised",
+		"analyze":      "analyse",
+		"size":         "size",
+		"capsize":      "capsize",
+		"prize":        "prize",
+		"seize":        "seize",
+		"maize":        "maize",
+		"color":        "color",
+	}
+
+	for input, expected := range cases {
+		if output := britishSuffix(input); output != expected {
+			t.Errorf("Failed for '%s': expected '%s', got '%s'", input, expected, output)
+		}
+	}
+}
+
+// Tests whether the inflection of verbs ending with 'l' follows
+// the requested spelling variant.
+func TestSpellingInflection(t *testing.T) {
+	cases := []struct {
+		verb   string
+		sp     Spelling
+		past   string
+		gerund string
+	}{
+		{"travel", SP_AMERICAN, "traveled", "traveling"},
+		{"travel", SP_BRITISH, "travelled", "travelling"},
+		{"cancel", SP_AMERICAN, "canceled", "canceling"},
+		{"fuel", SP_AMERICAN, "fueled", "fueling"},
+		{"fuel", SP_BRITISH, "fuelled", "fuelling"},
+		{"control", SP_AMERICAN, "controlled", "controlling"},
+		{"compel", SP_AMERICAN, "compelled", "compelling"},
+		{"gel", SP_AMERICAN, "gelled", "gelling"},
+		{"victual", SP_AMERICAN, "victualed", "victualing"},
+		{"victual", SP_BRITISH, "victualled", "victualling"},
+		{"vitriol", SP_AMERICAN, "vitrioled", "vitrioling"},
+		{"vitriol", SP_BRITISH, "vitriolled", "vitriolling"},
+	}
+
+	for _, c := range cases {
+		if output := pastRegular(c.verb, InflectOptions{spelling: c.sp}); output != c.past {
+			t.Errorf("Failed for '%s' (%d): expected '%s', got '%s'", c.verb, c.sp, c.past, output)
+		}
+		if output := gerund(c.verb, InflectOptions{spelling: c.sp}); output != c.gerund {
+			t.Errorf("Failed for '%s' (%d): expected '%s', got '%s'", c.verb, c.sp, c.gerund, output)
+		}
+	}
+
+	vvl := []struct {
+		verb     string
+		sp       Spelling
+		expected string
+	}{
+		{"fuel", SP_AMERICAN, "fueled"},
+		{"fuel", SP_BRITISH, "fuelled"},
+		{"victual", SP_AMERICAN, "victualed"},
+		{"victual", SP_BRITISH, "victualled"},
+		{"vitriol", SP_AMERICAN, "vitrioled"},
+		{"vitriol", SP_BRITISH, "vitriolled"},
+		{"conceal", SP_BRITISH, "concealed"},
+	}
+
+	for _, c := range vvl {
+		if output := handleVVL(c.verb, "ed", c.sp); output != c.expected {
+			t.Errorf("Failed for handleVVL('%s', %d): expected '%s', got '%s'", c.verb, c.sp, c.expected, output)
+		}
+	}
+}
+
+// Tests Generator.SetSpelling. Fails if the base words are not respelled,
+// the lemma index is not rebuilt or an undefined value is accepted.
+func TestGenerator_SetSpelling(t *testing.T) {
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	cases := []struct {
+		word     string
+		wc       WordClass
+		mods     Mod
+		sp       Spelling
+		expected string
+	}{
+		{"color", WC_NOUN, MOD_PLURAL, SP_DEFAULT, "colors"},
+		{"color", WC_NOUN, MOD_PLURAL, SP_BRITISH, "colours"},
+		{"organize", WC_VERB, MOD_GERUND, SP_BRITISH, "organising"},
+		{"grey", WC_ADJECTIVE, MOD_NONE, SP_DEFAULT, "grey"},
+		{"grey", WC_ADJECTIVE, MOD_NONE, SP_AMERICAN, "gray"},
+		{"travel", WC_VERB, MOD_PAST_SIMPLE, SP_AMERICAN, "traveled"},
+		{"travel", WC_VERB, MOD_PERFECT, SP_AMERICAN, "has traveled"},
+		{"victual", WC_VERB, MOD_PAST_SIMPLE, SP_AMERICAN, "victualed"},
+		{"vitriol", WC_VERB, MOD_GERUND, SP_AMERICAN, "vitrioling"},
+		{"victual", WC_VERB, MOD_PAST_SIMPLE, SP_BRITISH, "victualled"},
+		{"vitriol", WC_VERB, MOD_GERUND, SP_BRITISH, "vitriolling"},
+		{"travel", WC_VERB, MOD_PAST_SIMPLE, SP_DEFAULT, "travelled"},
+	}
+
+	for _, c := range cases {
+		if err := gen.SetSpelling(c.sp); err != nil {
+			t.Fatalf("Failed: SetSpelling returned an error: %v", err)
+		}
+
+		output, err := gen.Transform(c.word, c.wc, c.mods)
+		if err != nil {
+			t.Errorf("Failed for '%s' (%d): error returned: %v", c.word, c.sp, err)
+		} else if output != c.expected {
+			t.Errorf("Failed for '%s' (%d): expected '%s', got '%s'", c.word, c.sp, c.expected, output)
+		}
+	}
+
+	if _, err := gen.Lemmatize("colours"); !errors.Is(err, symbols.ErrNotFound) {
+		t.Errorf("Failed: British form lemmatized with SP_DEFAULT, error: %v", err)
+	}
+
+	if err := gen.SetSpelling(SP_BRITISH); err != nil {
+		t.Fatalf("Failed: SetSpelling returned an error: %v", err)
+	}
+
+	if _, err := gen.Lemmatize("colours"); err != nil {
+		t.Errorf("Failed: British form not lemmatized with SP_BRITISH: %v", err)
+	}
+
+	if err := gen.SetSpelling(SP_BRITISH + 1); !errors.Is(err, symbols.ErrUndefinedSpelling) {
+		t.Errorf("Failed: expected ErrUndefinedSpelling, got %v", err)
+	}
+
+	if sp := gen.Spelling(); sp != SP_BRITISH {
+		t.Errorf("Failed: expected SP_BRITISH, got %d", sp)
+	}
+}
@@ -20,17 +20,54 @@ package symbols
 import "errors"
 
 var (
+	// ErrBadBatchSize is returned by Generator.Batch if the requested number
+	// of phrases is not positive.
+	ErrBadBatchSize = errors.New("batch size equal or lower than 0")
+
+	// ErrBadDistance is returned by Generator.Batch and Generator.Search
+	// if a negative distance is specified.
+	ErrBadDistance = errors.New("negative distance")
+
 	// ErrBadIterLimit is returned by NewGenerator if a non-positive iterLimit
 	// is specified.
 	ErrBadIterLimit = errors.New("iteration limit equal or lower than 0")
 
+	// ErrBadLimit is returned by Generator.Search if a negative limit
+	// of results is specified.
+	ErrBadLimit = errors.New("negative limit of results")
+
+	// ErrBadRange is returned by Generator.Number if min is greater than max
+	// and by Generator.Phrase if number range specification is malformed.
+	ErrBadRange = errors.New("malformed or invalid number range")
+
+	// ErrBadScore is returned by Generator.SetMinPronounceability if
+	// the score lies outside the 0-100 range.
+	ErrBadScore = errors.New("pronounceability score outside the 0-100 range")
+
+	// ErrBadState is returned by Generator.UnmarshalBinary if the provided
+	// data is not a valid snapshot of the Generator's state.
+	ErrBadState = errors.New("malformed Generator state")
+
 	// ErrBadWordList is returned by NewWord if any line in the word list is
 	// incorrectly formatted or by NewGeneratorFromWord if any of the provided
 	// slices contains a nil pointer.
 	ErrBadWordList = errors.New("word list contains invalid element(s)")
 
+	// ErrConflictingWord is returned by DefaultGeneratorWithOverlay
+	// and Generator.AddWord if an added word is already present
+	// in the list with a different FormType or different irregular forms.
+	ErrConflictingWord = errors.New("word already exists with a different FormType or irregular forms")
+
+	// ErrCountable is returned by Generator.TransformWord if a countable
+	// noun is received along with MOD_MUCH, or a countable singular noun
+	// along with MOD_SOME.
+	ErrCountable = errors.New("determiner requires an uncountable noun")
+
 	// ErrEmptyLists is returned by NewGenerator and NewGeneratorFromWord
-	// if any of the user-provided lists is empty or nil.
+	// if any of the user-provided lists is empty or nil. Generator.RemoveWord
+	// and Generator.ReplaceList return it if the modification would leave
+	// the list empty, and DefaultGeneratorASCII and NewGeneratorASCII
+	// if no word of a list is eligible.
 	ErrEmptyLists = errors.New("empty list provided")
 
 	// ErrEmptyPattern is returned by Generator.Phrase if pattern is empty.
@@ -44,20 +81,37 @@ var (
 	// if pattern ends with '%'.
 	ErrEscapedStrTerm = errors.New("escape character at pattern termination")
 
+	// ErrExternalSource is returned by Generator.Seed and
+	// Generator.MarshalBinary if the Generator uses a source of random
+	// numbers provided by the user, whose seed and state are unknown.
+	ErrExternalSource = errors.New("source of random numbers provided by the user")
+
 	// ErrIncompatible is returned by Generator.TransformWord, if given
 	// WordClass is incompatible with requested transformations.
 	ErrIncompatible = errors.New("WordClass not compatible with the provided Mod(s)")
 
-	// ErrIterLimit is returned by Generator.Adjective, Generator.Adverb
-	// or Generator.Noun if iteration limit is reached while trying to
-	// generate a valid word to perform the requested gradation
-	// or pluralization.
+	// ErrIterLimit is returned by the generating methods of Generator
+	// if iteration limit is reached while trying to generate a valid word
+	// to perform the requested gradation or pluralization, or a word
+	// permitted by the homophone policy and the minimum pronounceability
+	// score.
 	ErrIterLimit = errors.New("iteration limit reached while trying to draw a comparable or countable word")
 
 	// ErrMalformedIrr is returned from NewWordFromParams, if ft == FT_IRREGULAR
 	// and irr has incorrect length or any of its elements is an empty string.
 	ErrMalformedIrr = errors.New("irregular forms slice is empty, too long, or contains an empty string")
 
+	// ErrNoWordList is returned by the methods of Generator accessing
+	// word lists if WC_PLACE is specified. Place names are built from
+	// embedded stems and suffixes, which do not form a word list.
+	ErrNoWordList = errors.New("WordClass has no word list")
+
+	// ErrNonASCII is returned by Generator.AddWord, Generator.ReplaceList
+	// and Generator.TransformWord if a Generator restricted to ASCII words
+	// receives, or would produce, a word containing characters other than
+	// ASCII letters.
+	ErrNonASCII = errors.New("word contains characters other than ASCII letters")
+
 	// ErrNonComparable is returned by Generator.TransformWord,
 	// if non-comparable adjective or adverb is received along
 	// with gradation modifier.
@@ -69,7 +123,9 @@ var (
 	ErrNonIrregular = errors.New("attempt to assign or get irregular forms, but Word is not irregular")
 
 	// ErrNotFound is returned by Generator.Find if the specified word
-	// is not found in the word database.
+	// is not found in the word database, or by DefaultGeneratorWithOverlay
+	// and Generator.RemoveWord if a word scheduled for removal is not present
+	// in the list.
 	ErrNotFound = errors.New("no matches found")
 
 	// ErrOutOfBounds returns an error if index value passed to Word.Irr
@@ -77,21 +133,49 @@ var (
 	ErrOutOfBounds = errors.New("index out of bounds")
 
 	// ErrPluralOnly is returned by Generator.TransformWord if a plural-only
-	// noun is received along with MOD_INDEF or MOD_INDEF_SILENT.
-	ErrPluralOnly = errors.New("indefinite article requested for plural-only noun")
+	// noun is received along with MOD_INDEF, MOD_INDEF_SILENT or MOD_EVERY.
+	ErrPluralOnly = errors.New("singular determiner requested for plural-only noun")
+
+	// ErrSingularOnly is returned by Generator.TransformWord if a singular
+	// pronoun is received along with MOD_PLURAL.
+	ErrSingularOnly = errors.New("plural requested for singular pronoun")
+
+	// ErrSpacedMod is returned by Generator.TransformWord if a Generator
+	// restricted to ASCII words receives a Mod that adds words
+	// or apostrophes, e.g. a determiner, a compound verb form or "more"
+	// and "most" gradation.
+	ErrSpacedMod = errors.New("Mod(s) would add words or apostrophes to an ASCII-only word")
 
 	// ErrSpecStrTerm is returned by Generator.Phrase if a pattern ends
 	// with transformation specifier (e.g "%t2").
 	ErrSpecStrTerm = errors.New("transformation specifier ends the pattern")
 
 	// ErrUncountable is returned by Generator.TransformWord if an uncountable
-	// noun is received along with MOD_INDEF, MOD_INDEF_SILENT or MOD_PLURAL.
-	ErrUncountable = errors.New("indefinite article or pluralization requested for uncountable noun")
+	// noun is received along with MOD_INDEF, MOD_INDEF_SILENT, MOD_EVERY,
+	// MOD_MANY or MOD_PLURAL.
+	ErrUncountable = errors.New("countable determiner or pluralization requested for uncountable noun")
+
+	// ErrUndefinedDistance is returned by Generator.Batch if an undefined
+	// Distance value is received.
+	ErrUndefinedDistance = errors.New("undefined Distance")
+
+	// ErrUndefinedException is returned by Generator.AddException and
+	// Generator.RemoveException if an undefined Exception value is received.
+	ErrUndefinedException = errors.New("undefined Exception")
 
 	// ErrUndefinedFormType is returned from NewWordFromParams if an undefined
 	// FormType is passed as ft parameter, e.g. FormType(123).
 	ErrUndefinedFormType = errors.New("undefined FormType")
 
+	// ErrUndefinedHomophones is returned by Generator.SetHomophones if
+	// an undefined Homophones value is received.
+	ErrUndefinedHomophones = errors.New("undefined Homophones")
+
+	// ErrUndefinedListFormat is returned by Generator.Export,
+	// Generator.ExportList and Import if an undefined ListFormat value
+	// is received.
+	ErrUndefinedListFormat = errors.New("undefined ListFormat")
+
 	// ErrUndefinedMod is returned by Generator.TransformWord if an undefined
 	// modifier value is received, e.g. Mod(65536).
 	ErrUndefinedMod = errors.New("undefined modifier")
@@ -100,7 +184,15 @@ var (
 	// an undefined escaped character.
 	ErrUndefinedSpecifier = errors.New("undefined specifier")
 
+	// ErrUndefinedSpelling is returned by Generator.SetSpelling if an undefined
+	// Spelling value is received.
+	ErrUndefinedSpelling = errors.New("undefined Spelling")
+
 	// ErrUndefinedWordClass is returned by Generator.Find if an undefined
 	// WordClass value is received, e.g. WordClass(123).
 	ErrUndefinedWordClass = errors.New("undefined WordClass")
+
+	// ErrUnsupportedLanguage is returned by DefaultGeneratorForLanguage
+	// if there is no embedded language pack for the requested Language.
+	ErrUnsupportedLanguage = errors.New("no embedded language pack for the requested Language")
 )
@@ -0,0 +1,135 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import "strings"
+
+// mod_compound groups Mods that produce compound verb forms, consisting
+// of the verb preceded by auxiliaries or negation.
+const mod_compound Mod = MOD_FUTURE | MOD_PERFECT | MOD_PROGRESSIVE | MOD_INFINITIVE |
+	MOD_NEGATIVE | MOD_NEGATIVE_CONTRACTED | MOD_PASSIVE
+
+// Auxiliary verbs used to build compound verb forms. Their finite forms
+// are produced by presentSimple and pastSimple, like those of any other verb.
+var (
+	auxBe   = Word{ft: FT_REGULAR, word: "be"}
+	auxDo   = Word{ft: FT_IRREGULAR, irr: &[]string{"did", "done"}, word: "do"}
+	auxHave = Word{ft: FT_IRREGULAR, irr: &[]string{"had", "had"}, word: "have"}
+)
+
+// contractions maps auxiliaries to their contracted negative forms.
+var contractions = map[string]string{
+	"are":  "aren't",
+	"did":  "didn't",
+	"do":   "don't",
+	"does": "doesn't",
+	"had":  "hadn't",
+	"has":  "hasn't",
+	"have": "haven't",
+	"is":   "isn't",
+	"was":  "wasn't",
+	"were": "weren't",
+	"will": "won't",
+}
+
+// compound returns a compound form of a verb, built according to tense,
+// aspect, voice and negation Mods enabled in mods. The first verb
+// of the chain is preceded by 'will' (MOD_FUTURE) or 'to' (MOD_INFINITIVE),
+// or takes the Past Simple (MOD_PAST_SIMPLE) or Present Simple form.
+// Every subsequent verb takes the form required by the preceding auxiliary:
+//
+//	will run, has run, had been running, are running, to have run, is being eaten
+//
+// Negation is placed after the first auxiliary. If there is none,
+// auxiliary 'do' is inserted, unless the verb is 'be'. Infinitives
+// are preceded by 'not'. Verbs are inflected according to opts, but
+// the forms set with Generator.SetForm do not affect the auxiliaries.
+func compound(word Word, mods Mod, opts InflectOptions) string {
+	plural := mods.Enabled(MOD_PLURAL)
+
+	aux := opts
+	aux.form = nil
+
+	var (
+		// Words preceding the verb
+		parts = make([]string, 0, 4)
+
+		// Transforms the next verb of the chain
+		form func(Word, InflectOptions) string
+	)
+
+	switch true {
+	case mods.Enabled(MOD_FUTURE):
+		parts = append(parts, "will")
+		form = func(w Word, _ InflectOptions) string { return w.word }
+	case mods.Enabled(MOD_INFINITIVE):
+		parts = append(parts, "to")
+		form = func(w Word, _ InflectOptions) string { return w.word }
+	case mods.Enabled(MOD_PAST_SIMPLE):
+		form = func(w Word, o InflectOptions) string { return pastSimple(w, plural, o) }
+	default:
+		form = func(w Word, o InflectOptions) string { return presentSimple(w.word, plural, o) }
+	}
+
+	if mods.Enabled(MOD_PERFECT) {
+		parts = append(parts, form(auxHave, aux))
+		form = pastParticiple
+	}
+
+	if mods.Enabled(MOD_PROGRESSIVE) {
+		parts = append(parts, form(auxBe, aux))
+		form = func(w Word, o InflectOptions) string { return gerund(w.word, o) }
+	}
+
+	if mods.Enabled(MOD_PASSIVE) {
+		parts = append(parts, form(auxBe, aux))
+		form = pastParticiple
+	}
+
+	negative := mods.Enabled(MOD_NEGATIVE | MOD_NEGATIVE_CONTRACTED)
+
+	if negative && len(parts) == 0 && word.word != "be" {
+		parts = append(parts, form(auxDo, aux))
+		form = func(w Word, _ InflectOptions) string { return w.word }
+	}
+
+	parts = append(parts, form(word, opts))
+
+	if negative {
+		parts = negate(parts, !mods.Enabled(MOD_NEGATIVE))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// negate inserts negation after the first element of parts, which is
+// expected to be an auxiliary (or 'be'), or before it, if it is 'to'.
+// If contracted is true and a contracted form of the auxiliary exists,
+// the auxiliary is replaced with it instead.
+func negate(parts []string, contracted bool) []string {
+	if parts[0] == "to" {
+		return append([]string{"not"}, parts...)
+	}
+
+	if c, ok := contractions[parts[0]]; ok && contracted {
+		parts[0] = c
+		return parts
+	}
+
+	return append(parts[:1], append([]string{"not"}, parts[1:]...)...)
+}
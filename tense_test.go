@@ -0,0 +1,77 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Zedran/neng/internal/tests"
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests compound verb forms produced by Generator.TransformWord. Fails
+// if an auxiliary or negation is missing, misplaced or improperly
+// conjugated.
+func TestCompound(t *testing.T) {
+	type testCase struct {
+		Input    string `json:"input"`
+		Mods     Mod    `json:"mods"`
+		Expected string `json:"expected"`
+	}
+
+	var cases []testCase
+	if err := tests.ReadData("TestCompound.json", &cases); err != nil {
+		t.Fatalf("Failed loading test data: %v", err)
+	}
+
+	gen, err := DefaultGenerator(nil)
+	if err != nil {
+		t.Fatalf("Failed: DefaultGenerator returned an error: %v", err)
+	}
+
+	for _, c := range cases {
+		output, err := gen.Transform(c.Input, WC_VERB, c.Mods)
+		if err != nil {
+			t.Errorf("Failed for '%s' (%s): error returned: %v", c.Input, c.Mods, err)
+			continue
+		}
+
+		if output != c.Expected {
+			t.Errorf("Failed for '%s' (%s): expected '%s', got '%s'", c.Input, c.Mods, c.Expected, output)
+		}
+	}
+
+	incompatible := []Mod{
+		MOD_FUTURE | MOD_PAST_SIMPLE,
+		MOD_FUTURE | MOD_INFINITIVE,
+		MOD_INFINITIVE | MOD_PLURAL,
+		MOD_PERFECT | MOD_GERUND,
+		MOD_PROGRESSIVE | MOD_PAST_PARTICIPLE,
+		MOD_PASSIVE | MOD_GERUND,
+		MOD_NEGATIVE | MOD_PAST_PARTICIPLE,
+		MOD_NEGATIVE | MOD_NEGATIVE_CONTRACTED,
+		MOD_NEGATIVE_CONTRACTED | MOD_INFINITIVE | MOD_PLURAL,
+	}
+
+	for _, m := range incompatible {
+		if _, err := gen.Transform("run", WC_VERB, m); !errors.Is(err, symbols.ErrIncompatible) {
+			t.Errorf("Failed for %s: expected ErrIncompatible, got %v", m, err)
+		}
+	}
+}
@@ -18,8 +18,11 @@
 package neng
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/Zedran/neng/internal/phon"
 )
 
 // cmpWord is a comparison function for slices.IsSortedFunc
@@ -32,45 +35,7 @@ func cmpWord(a, b Word) int {
 // sequence. General accuracy of this function is not very high, especially
 // for borrowed words (cafe). It targets specific groups of verbs.
 func countSyllables(s, seq string) int {
-	if len(s) == 0 {
-		return 0
-	}
-
-	var (
-		prevVowel bool
-		count     int
-	)
-
-	for _, sc := range seq {
-		switch sc {
-		case 'v':
-			if !prevVowel {
-				// Diphthongs and long vowels are part of one syllable
-				prevVowel = true
-				count++
-			}
-		default:
-			prevVowel = false
-		}
-	}
-
-	if count > 1 && endsWithAny(s, []string{"eat", "eate", "iate", "uate"}) {
-		// When apparent diphthongs are in fact individual vowels
-		// and belong to separate syllables
-		count++
-	}
-
-	if strings.HasSuffix(s, "e") && strings.HasSuffix(seq, "cv") {
-		// A final 'e' preceded by a consonant (silent 'e')
-		// does not constitute the next syllable
-		count--
-	}
-
-	if count == 0 {
-		return 1
-	}
-
-	return count
+	return phon.Syllables(s, seq)
 }
 
 // doubleFinal returns the verb with its final consonant doubled
@@ -92,23 +57,7 @@ func endsWithAny(s string, suf []string) bool {
 
 // getSequence returns a vowel-consonant sequence of s ('word' == 'cvcc').
 func getSequence(s string) string {
-	var (
-		seq    strings.Builder
-		vowels string = "aeiou"
-	)
-
-	for i, c := range s {
-		if i == len(s)-1 && c == 'y' && !strings.ContainsRune(vowels, rune(s[i-1])) {
-			// A special case of final 'y' following a consonant
-			seq.WriteByte('v')
-		} else if strings.ContainsRune(vowels, c) {
-			seq.WriteByte('v')
-		} else {
-			seq.WriteByte('c')
-		}
-	}
-
-	return seq.String()
+	return phon.Sequence(s)
 }
 
 // parseLines converts lines into a slice of Word.
@@ -127,3 +76,29 @@ func parseLines(lines []string) ([]Word, error) {
 
 	return words, nil
 }
+
+// unmarshalEnum decodes data holding either a JSON string, which is parsed
+// by text, or the numeric value of an enumeration type, which is stored in v.
+// Returns errUndefined if the number is reported as undefined by undefined
+// or does not fit in T.
+func unmarshalEnum[T ~uint8 | ~uint](data []byte, v *T, undefined func(T) bool, errUndefined error, text func([]byte) error) error {
+	if len(data) == 0 || data[0] != '"' {
+		var n uint64
+		if err := json.Unmarshal(data, &n); err != nil {
+			return err
+		}
+
+		if t := T(n); uint64(t) == n && !undefined(t) {
+			*v = t
+			return nil
+		}
+		return errUndefined
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	return text([]byte(s))
+}
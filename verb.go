@@ -18,16 +18,20 @@
 package neng
 
 import (
-	"slices"
 	"strings"
 )
 
 // gerund returns a gerund form of a verb.
-func gerund(verb string) string {
+func gerund(verb string, opts InflectOptions) string {
+	if form, ok := opts.override(MOD_GERUND); ok {
+		return form
+	}
+
+	if form, ok := opts.exception(verb, "ing"); ok {
+		return form
+	}
+
 	if len(verb) <= 2 {
-		if verb == "up" {
-			return doubleFinal(verb, "ing")
-		}
 		return verb + "ing"
 	}
 
@@ -47,10 +51,10 @@ func gerund(verb string) string {
 	case 'y', 'h', 'w', 'x':
 		return verb + "ing"
 	case 'r':
-		return handleR(verb, "ing")
+		return verb + "ing"
 	case 'l':
 		if strings.HasSuffix(getSequence(verb), "vvc") {
-			return handleVVL(verb, "ing")
+			return handleVVL(verb, "ing", opts.spelling)
 		}
 	case 's':
 		if strings.HasSuffix(verb, "gas") {
@@ -64,17 +68,10 @@ func gerund(verb string) string {
 		return handleIt(verb, "ing")
 	}
 
-	if verb == "quiz" {
-		return doubleFinal(verb, "ing")
-	}
-
 	seq := getSequence(verb)
 
 	if strings.HasSuffix(seq, "cvc") {
-		return handleCVC(verb, "ing", seq, []string{
-			"beget", "begin", "beset", "forget", "inset", "offset", "overrun",
-			"reset", "sublet", "typeset", "underrun", "upset",
-		})
+		return handleCVC(verb, "ing", seq, opts.spelling)
 	}
 
 	return verb + "ing"
@@ -85,48 +82,27 @@ func gerund(verb string) string {
 //
 //   - tenseEnding: '-ing' or '-ed'
 //   - seq: vowel-consonant sequence
-//   - tenseExceptions: tense-specific words whose endings are doubled,
-//     regardless of transformation rules based on syllable count
-//     and verb endings
-func handleCVC(verb, tenseEnding, seq string, tenseExceptions []string) string {
-	if strings.HasSuffix(verb, "c") && verb != "sic" {
-		if strings.HasSuffix(verb, "lyric") {
-			return verb + tenseEnding
-		}
+//   - sp: spelling variant, which decides whether the final 'l' is doubled
+//
+// Exceptions to the rules are listed in EX_DOUBLED and EX_SINGLE, which
+// are handled by the callers.
+func handleCVC(verb, tenseEnding, seq string, sp Spelling) string {
+	if strings.HasSuffix(verb, "c") {
 		// If final letter is 'c', add 'k' before tenseEnding
 		return verb + "k" + tenseEnding
 	}
 
-	commonSingleExceptions := []string{
-		"batik", "kayak", "orphan", "pyramid", "wedel",
-	}
-
-	commonDoubledExceptions := []string{
-		"abet", "anagram", "curvet", "regret", "revet", "underpin", "unpin",
-	}
-
-	if slices.Contains(commonSingleExceptions, verb) {
-		return verb + tenseEnding
-	}
-
-	if slices.Contains(commonDoubledExceptions, verb) {
-		// Double the final consonant for exceptions that are common
-		// for past forms and gerund
-		return doubleFinal(verb, tenseEnding)
-	}
-
-	// nil-safe, omitting check
-	if slices.Contains(tenseExceptions, verb) {
-		// Double the final consonant for exceptions specific to the caller
-		return doubleFinal(verb, tenseEnding)
-	}
+	sylCount := countSyllables(verb, seq)
 
 	if strings.HasSuffix(verb, "l") {
+		if sp == SP_AMERICAN && sylCount > 1 && !endsWithAny(verb, []string{"annul", "control", "excel", "extol", "patrol", "pel", "rebel"}) {
+			// American spelling doubles the final 'l' only
+			// if the last syllable is stressed
+			return verb + tenseEnding
+		}
 		return doubleFinal(verb, tenseEnding)
 	}
 
-	sylCount := countSyllables(verb, seq)
-
 	if sylCount == 2 {
 		if endsWithAny(verb, []string{"en", "et", "in", "om", "on"}) {
 			// Do not double the final consonant of bisyllabic verbs
@@ -162,25 +138,6 @@ func handleIt(verb, tenseEnding string) string {
 	}
 
 	if endsWithAny(verb, []string{"fit", "mit", "wit"}) {
-		if verb != "limit" && verb != "profit" {
-			return doubleFinal(verb, tenseEnding)
-		}
-	}
-
-	return verb + tenseEnding
-}
-
-// handleR transforms verbs ending with '-r'.
-func handleR(verb, tenseEnding string) string {
-	doubled := []string{
-		"abhor", "bar", "bestir", "blur", "bur", "char", "concur",
-		"confer", "debar", "demur", "deter", "disbar", "disinter",
-		"incur", "jar", "mar", "occur", "par", "prefer", "recur",
-		"refer", "scar", "slur", "spar", "spur", "star", "stir", "tar",
-		"transfer", "unbar", "war",
-	}
-
-	if slices.Contains(doubled, verb) {
 		return doubleFinal(verb, tenseEnding)
 	}
 
@@ -188,8 +145,9 @@ func handleR(verb, tenseEnding string) string {
 }
 
 // handleVVL transforms verbs ending with vowel-vowel-l sequence.
-func handleVVL(verb, tenseEnding string) string {
-	if strings.HasSuffix(verb, "uel") || verb == "victual" || verb == "vitriol" {
+// American spelling never doubles the final 'l' in such verbs.
+func handleVVL(verb, tenseEnding string, sp Spelling) string {
+	if sp != SP_AMERICAN && (strings.HasSuffix(verb, "uel") || verb == "victual" || verb == "vitriol") {
 		return doubleFinal(verb, tenseEnding)
 	}
 
@@ -197,7 +155,11 @@ func handleVVL(verb, tenseEnding string) string {
 }
 
 // pastParticiple returns Past Participle form of a verb.
-func pastParticiple(word Word) string {
+func pastParticiple(word Word, opts InflectOptions) string {
+	if form, ok := opts.override(MOD_PAST_PARTICIPLE); ok {
+		return form
+	}
+
 	if word.ft == FT_IRREGULAR {
 		return (*word.irr)[1]
 	}
@@ -206,21 +168,25 @@ func pastParticiple(word Word) string {
 		return "been"
 	}
 
-	return pastRegular(word.word)
+	return pastRegular(word.word, opts)
 }
 
 // pastRegular appends past tense suffix to a regular verb.
-func pastRegular(verb string) string {
+func pastRegular(verb string, opts InflectOptions) string {
+	if form, ok := opts.exception(verb, "ed"); ok {
+		return form
+	}
+
 	switch verb[len(verb)-1] {
 	case 'e':
 		return verb + "d"
 	case 'r':
-		return handleR(verb, "ed")
+		return verb + "ed"
 	case 'h', 'w', 'o', 'x', 'a', 'i', 'u':
 		return verb + "ed"
 	case 'l':
 		if strings.HasSuffix(getSequence(verb), "vvc") {
-			return handleVVL(verb, "ed")
+			return handleVVL(verb, "ed", opts.spelling)
 		}
 	case 'y':
 		if strings.HasSuffix(getSequence(verb), "v") {
@@ -239,21 +205,27 @@ func pastRegular(verb string) string {
 		return handleIt(verb, "ed")
 	}
 
-	if verb == "quiz" || verb == "up" {
-		return doubleFinal(verb, "ed")
-	}
-
 	seq := getSequence(verb)
 
 	if strings.HasSuffix(seq, "cvc") {
-		return handleCVC(verb, "ed", seq, nil)
+		return handleCVC(verb, "ed", seq, opts.spelling)
 	}
 
 	return verb + "ed"
 }
 
-// pastSimple returns Past Simple form of a verb.
-func pastSimple(word Word, plural bool) string {
+// pastSimple returns Past Simple form of a verb. The singular form set
+// with Generator.SetForm applies to the plural, unless the plural form
+// is set as well.
+func pastSimple(word Word, plural bool, opts InflectOptions) string {
+	if form, ok := opts.override(MOD_PAST_SIMPLE | MOD_PLURAL); ok && plural {
+		return form
+	}
+
+	if form, ok := opts.override(MOD_PAST_SIMPLE); ok {
+		return form
+	}
+
 	if word.ft == FT_IRREGULAR {
 		return (*word.irr)[0]
 	}
@@ -265,11 +237,20 @@ func pastSimple(word Word, plural bool) string {
 		return "was"
 	}
 
-	return pastRegular(word.word)
+	return pastRegular(word.word, opts)
 }
 
 // presentSimple returns Present Simple form of a verb.
-func presentSimple(verb string, plural bool) string {
+func presentSimple(verb string, plural bool, opts InflectOptions) string {
+	mods := MOD_PRESENT_SIMPLE
+	if plural {
+		mods |= MOD_PLURAL
+	}
+
+	if form, ok := opts.override(mods); ok {
+		return form
+	}
+
 	if plural {
 		if verb == "be" {
 			return "are"
@@ -31,7 +31,7 @@ func TestGerund(t *testing.T) {
 	}
 
 	for input, expected := range cases {
-		output := gerund(input)
+		output := gerund(input, InflectOptions{})
 
 		if output != expected {
 			t.Errorf("Failed for '%s': expected '%s', got '%s'", input, expected, output)
@@ -64,7 +64,7 @@ func TestPastParticiple(t *testing.T) {
 			}
 		}
 
-		output := pastParticiple(word)
+		output := pastParticiple(word, InflectOptions{})
 
 		if output != expected {
 			t.Errorf("Failed for '%s': expected '%s', got '%s'", input, expected, output)
@@ -81,7 +81,7 @@ func TestPastRegular(t *testing.T) {
 	}
 
 	for input, expected := range cases {
-		output := pastRegular(input)
+		output := pastRegular(input, InflectOptions{})
 
 		if output != expected {
 			t.Errorf("Failed for '%s': expected '%s', got '%s'", input, expected, output)
@@ -120,7 +120,7 @@ func TestPastSimple(t *testing.T) {
 			}
 		}
 
-		output := pastSimple(word, c.Plural)
+		output := pastSimple(word, c.Plural, InflectOptions{})
 
 		if output != c.Expected {
 			t.Errorf("Failed for '%s' (plural = %v): expected '%s', got '%s'", c.Input, c.Plural, c.Expected, output)
@@ -143,7 +143,7 @@ func TestPresentSimple(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		output := presentSimple(c.Input, c.Plural)
+		output := presentSimple(c.Input, c.Plural, InflectOptions{})
 
 		if output != c.Expected {
 			number := "sing."
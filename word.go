@@ -18,6 +18,7 @@
 package neng
 
 import (
+	"encoding/json"
 	"strings"
 
 	"github.com/Zedran/neng/symbols"
@@ -36,14 +37,14 @@ type Word struct {
 }
 
 // Returns FormType of the Word.
-func (w *Word) FT() FormType {
+func (w Word) FT() FormType {
 	return w.ft
 }
 
 // Returns an irregular form of the Word at index i of the underlying slice
 // of irregular forms. Returns an error if called for a non-irregular word
 // or if i is out of bounds of the slice.
-func (w *Word) Irr(i int) (string, error) {
+func (w Word) Irr(i int) (string, error) {
 	if w.ft != FT_IRREGULAR {
 		return "", symbols.ErrNonIrregular
 	}
@@ -55,11 +56,93 @@ func (w *Word) Irr(i int) (string, error) {
 	return (*w.irr)[i], nil
 }
 
+// MarshalJSON implements json.Marshaler. The Word is encoded as an object
+// containing the word, the name of its FormType and irregular forms,
+// if present:
+//
+//	{"word":"good","form_type":"FT_IRREGULAR","irr":["better","best"]}
+func (w Word) MarshalJSON() ([]byte, error) {
+	jw := wordJSON{Word: w.word, FT: w.ft.String()}
+	if w.irr != nil {
+		jw.Irr = *w.irr
+	}
+	return json.Marshal(jw)
+}
+
+// MarshalText implements encoding.TextMarshaler. The Word is encoded
+// in the word list line format (see NewGenerator).
+func (w Word) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// String returns the Word in the word list line format, which can be parsed
+// back with NewWord:
+//
+//	<FormType><word>[,irr1][,irr2]
+func (w Word) String() string {
+	var sb strings.Builder
+
+	sb.WriteByte(byte(w.ft) + 48)
+	sb.WriteString(w.word)
+
+	if w.irr != nil {
+		for _, f := range *w.irr {
+			sb.WriteByte(',')
+			sb.WriteString(f)
+		}
+	}
+
+	return sb.String()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It decodes the object created
+// by Word.MarshalJSON. Relays an error from NewWordFromParams if the decoded
+// Word is invalid.
+func (w *Word) UnmarshalJSON(data []byte) error {
+	var jw wordJSON
+	if err := json.Unmarshal(data, &jw); err != nil {
+		return err
+	}
+
+	ft, err := parseFormType(jw.FT)
+	if err != nil {
+		return err
+	}
+
+	nw, err := NewWordFromParams(jw.Word, ft, jw.Irr)
+	if err != nil {
+		return err
+	}
+
+	*w = nw
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It parses a word list
+// line. Relays an error from NewWord if the line is malformed.
+func (w *Word) UnmarshalText(text []byte) error {
+	nw, err := NewWord(string(text))
+	if err != nil {
+		return err
+	}
+
+	*w = nw
+	return nil
+}
+
 // Word returns base form of the word.
-func (w *Word) Word() string {
+func (w Word) Word() string {
 	return w.word
 }
 
+// wordJSON is an intermediate structure used to encode and decode Word
+// in JSON format.
+type wordJSON struct {
+	Word string   `json:"word"`
+	FT   string   `json:"form_type"`
+	Irr  []string `json:"irr,omitempty"`
+}
+
 // NewWord parses a single word list line into a new word struct.
 // Returns an error if malformed line is encountered.
 func NewWord(line string) (Word, error) {
@@ -17,6 +17,14 @@
 
 package neng
 
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+
+	"github.com/Zedran/neng/symbols"
+)
+
 // WordClass helps the Generator to differentiate parts of speech.
 type WordClass uint8
 
@@ -25,6 +33,20 @@ const (
 	WC_ADVERB
 	WC_NOUN
 	WC_VERB
+	WC_PRONOUN
+	WC_PREPOSITION
+	WC_CONJUNCTION
+	WC_INTERJECTION
+	WC_GIVEN_NAME
+	WC_SURNAME
+
+	// Place names are built from embedded stems and suffixes (Dunmere),
+	// which do not form a word list. Methods accessing word lists
+	// return symbols.ErrNoWordList for WC_PLACE.
+	WC_PLACE
+
+	// Internal value, declared to mark the end of usable WordClass values.
+	wc_undefined
 )
 
 // CompatibleWith returns true if WordClass is compatible with all of the
@@ -32,16 +54,27 @@ const (
 // values have undefined compatibility. Use Mod.Undefined to ensure that
 // all bits in Mod have defined values.
 func (wc WordClass) CompatibleWith(mods Mod) bool {
+	if mods.Enabled(MOD_ORDINAL|MOD_SPELLED) || bits.OnesCount(uint(mods&(mod_determiner|MOD_INDEF_SILENT))) > 1 {
+		return false
+	}
+
+	if wc != WC_PRONOUN && mods.Enabled(MOD_OBJECTIVE) {
+		return false
+	}
+
 	switch wc {
 	case WC_ADJECTIVE, WC_ADVERB:
-		if mods.Enabled(MOD_PLURAL | MOD_PAST_SIMPLE | MOD_PAST_PARTICIPLE | MOD_PRESENT_SIMPLE | MOD_GERUND | MOD_POSSESSIVE | MOD_INDEF_SILENT) {
+		if mods.Enabled(MOD_PLURAL | MOD_PAST_SIMPLE | MOD_PAST_PARTICIPLE | MOD_PRESENT_SIMPLE | MOD_GERUND | MOD_POSSESSIVE | MOD_INDEF_SILENT | MOD_DET_SILENT | mod_compound) {
+			return false
+		}
+		if wc == WC_ADVERB && mods.Enabled(mod_determiner&^MOD_INDEF) {
 			return false
 		}
 		if mods.Enabled(MOD_INDEF) && mods.Enabled(MOD_SUPERLATIVE) {
 			return false
 		}
 	case WC_NOUN:
-		if mods.Enabled(MOD_PAST_SIMPLE | MOD_PAST_PARTICIPLE | MOD_PRESENT_SIMPLE | MOD_GERUND | MOD_COMPARATIVE | MOD_SUPERLATIVE) {
+		if mods.Enabled(MOD_PAST_SIMPLE | MOD_PAST_PARTICIPLE | MOD_PRESENT_SIMPLE | MOD_GERUND | MOD_COMPARATIVE | MOD_SUPERLATIVE | mod_compound) {
 			return false
 		}
 		if mods.Enabled(MOD_INDEF) && mods.Enabled(MOD_PLURAL) {
@@ -50,13 +83,105 @@ func (wc WordClass) CompatibleWith(mods Mod) bool {
 		if mods.Enabled(MOD_INDEF_SILENT) && mods.Enabled(MOD_PLURAL|MOD_INDEF) {
 			return false
 		}
+		if mods.Enabled(MOD_PLURAL) && mods.Enabled(MOD_EVERY|MOD_MUCH) {
+			return false
+		}
 	case WC_VERB:
-		if mods.Enabled(MOD_INDEF | MOD_COMPARATIVE | MOD_SUPERLATIVE | MOD_POSSESSIVE | MOD_INDEF_SILENT) {
+		if mods.Enabled(MOD_COMPARATIVE | MOD_SUPERLATIVE | MOD_POSSESSIVE | MOD_INDEF_SILENT | MOD_DET_SILENT | mod_determiner) {
+			return false
+		}
+		if mods.Enabled(MOD_PLURAL) && !mods.Enabled(MOD_PAST_SIMPLE|MOD_PRESENT_SIMPLE|mod_compound&^MOD_INFINITIVE) {
+			return false
+		}
+		if mods.Enabled(mod_compound) && mods.Enabled(MOD_PAST_PARTICIPLE|MOD_GERUND) {
+			return false
+		}
+		if mods.Enabled(MOD_NEGATIVE) && mods.Enabled(MOD_NEGATIVE_CONTRACTED) {
+			return false
+		}
+		if mods.Enabled(MOD_FUTURE) && mods.Enabled(MOD_PAST_SIMPLE|MOD_PRESENT_SIMPLE) {
+			return false
+		}
+		if mods.Enabled(MOD_INFINITIVE) && mods.Enabled(MOD_PAST_SIMPLE|MOD_PRESENT_SIMPLE|MOD_FUTURE|MOD_PLURAL) {
+			return false
+		}
+	case WC_PRONOUN:
+		if mods.Enabled(^(MOD_PLURAL | MOD_OBJECTIVE | mod_case)) {
+			return false
+		}
+	case WC_PREPOSITION, WC_CONJUNCTION, WC_INTERJECTION:
+		if mods.Enabled(^mod_case) {
 			return false
 		}
-		if mods.Enabled(MOD_PLURAL) && !mods.Enabled(MOD_PAST_SIMPLE|MOD_PRESENT_SIMPLE) {
+	case WC_GIVEN_NAME, WC_PLACE:
+		if mods.Enabled(^(MOD_POSSESSIVE | mod_case)) {
+			return false
+		}
+	case WC_SURNAME:
+		if mods.Enabled(^(MOD_PLURAL | MOD_POSSESSIVE | mod_case)) {
 			return false
 		}
 	}
 	return true
 }
+
+// name returns true if wc is a class of proper names. Proper names
+// are written in Title Case, unless a case Mod says otherwise.
+func (wc WordClass) name() bool {
+	return wc >= WC_GIVEN_NAME && wc < wc_undefined
+}
+
+// wcNames holds the names of WordClass constants, indexed by their values.
+var wcNames = [...]string{
+	"WC_ADJECTIVE", "WC_ADVERB", "WC_NOUN", "WC_VERB", "WC_PRONOUN",
+	"WC_PREPOSITION", "WC_CONJUNCTION", "WC_INTERJECTION", "WC_GIVEN_NAME",
+	"WC_SURNAME", "WC_PLACE",
+}
+
+// MarshalText implements encoding.TextMarshaler. The WordClass is encoded
+// as the name of its constant. Returns symbols.ErrUndefinedWordClass if wc holds
+// an undefined value.
+func (wc WordClass) MarshalText() ([]byte, error) {
+	if int(wc) >= len(wcNames) {
+		return nil, symbols.ErrUndefinedWordClass
+	}
+	return []byte(wcNames[wc]), nil
+}
+
+// String returns the name of the WordClass constant, e.g. "WC_NOUN".
+// For undefined values, returns "WordClass(n)".
+func (wc WordClass) String() string {
+	if int(wc) < len(wcNames) {
+		return wcNames[wc]
+	}
+	return fmt.Sprintf("WordClass(%d)", wc)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. In addition to the string
+// produced by WordClass.MarshalText, it accepts the numeric value
+// of the WordClass. Returns symbols.ErrUndefinedWordClass if the value is undefined.
+func (wc *WordClass) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, wc, func(wc WordClass) bool { return wc >= wc_undefined }, symbols.ErrUndefinedWordClass, wc.UnmarshalText)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the name
+// of a WordClass constant. Returns symbols.ErrUndefinedWordClass if the name
+// is not recognized.
+func (wc *WordClass) UnmarshalText(text []byte) error {
+	v, err := parseWordClass(string(text))
+	if err != nil {
+		return err
+	}
+
+	*wc = v
+	return nil
+}
+
+// parseWordClass returns WordClass corresponding to the name of its constant.
+// Returns symbols.ErrUndefinedWordClass if name is not recognized.
+func parseWordClass(name string) (WordClass, error) {
+	if i := slices.Index(wcNames[:], name); i != -1 {
+		return WordClass(i), nil
+	}
+	return 0, fmt.Errorf("'%s': %w", name, symbols.ErrUndefinedWordClass)
+}
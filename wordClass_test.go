@@ -17,7 +17,10 @@
 
 package neng
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 // Tests WordClass.CompatibleWith. Fails if compatibility assessment
 // is not consistent with documentation.
@@ -35,9 +38,17 @@ func TestWordClass_CompatibleWith(t *testing.T) {
 		{true, WC_NOUN, MOD_POSSESSIVE},
 		{true, WC_NOUN, MOD_INDEF},
 		{true, WC_NOUN, MOD_INDEF_SILENT},
+		{true, WC_NOUN, MOD_DEF | MOD_PLURAL | MOD_POSSESSIVE},
+		{true, WC_NOUN, MOD_MANY | MOD_DET_SILENT},
+		{true, WC_ADJECTIVE, MOD_DEF | MOD_SUPERLATIVE},
+		{true, WC_ADJECTIVE, MOD_MUCH},
 		{true, WC_VERB, MOD_PAST_SIMPLE | MOD_PAST_PARTICIPLE | MOD_PRESENT_SIMPLE | MOD_GERUND | MOD_PLURAL | MOD_CASE_LOWER | MOD_CASE_TITLE | MOD_CASE_UPPER},
 		{true, WC_VERB, MOD_PAST_SIMPLE},
 		{true, WC_VERB, MOD_PRESENT_SIMPLE},
+		{true, WC_VERB, MOD_FUTURE | MOD_PERFECT | MOD_PROGRESSIVE | MOD_PLURAL},
+		{true, WC_VERB, MOD_INFINITIVE | MOD_PERFECT},
+		{true, WC_VERB, MOD_NEGATIVE | MOD_PASSIVE | MOD_PLURAL},
+		{true, WC_VERB, MOD_NEGATIVE_CONTRACTED | MOD_PAST_SIMPLE | MOD_PLURAL},
 		{false, WC_ADJECTIVE, MOD_GERUND},
 		{false, WC_ADJECTIVE, MOD_PLURAL},
 		{false, WC_ADJECTIVE, MOD_INDEF | MOD_SUPERLATIVE},
@@ -57,6 +68,37 @@ func TestWordClass_CompatibleWith(t *testing.T) {
 		{false, WC_VERB, MOD_POSSESSIVE},
 		{false, WC_VERB, MOD_INDEF},
 		{false, WC_VERB, MOD_INDEF_SILENT},
+		{false, WC_VERB, MOD_INFINITIVE | MOD_PLURAL},
+		{false, WC_VERB, MOD_FUTURE | MOD_PAST_SIMPLE},
+		{false, WC_VERB, MOD_NEGATIVE | MOD_NEGATIVE_CONTRACTED},
+		{false, WC_VERB, MOD_NEGATIVE | MOD_NEGATIVE_CONTRACTED | MOD_PAST_SIMPLE},
+		{false, WC_VERB, MOD_PERFECT | MOD_GERUND},
+		{false, WC_NOUN, MOD_PROGRESSIVE},
+		{false, WC_ADJECTIVE, MOD_FUTURE},
+		{false, WC_VERB, MOD_PASSIVE | MOD_GERUND},
+		{false, WC_NOUN, MOD_NEGATIVE},
+		{false, WC_ADVERB, MOD_PASSIVE},
+		{false, WC_NOUN, MOD_DEF | MOD_INDEF},
+		{false, WC_NOUN, MOD_SOME | MOD_INDEF_SILENT},
+		{false, WC_NOUN, MOD_EVERY | MOD_PLURAL},
+		{false, WC_NOUN, MOD_MUCH | MOD_PLURAL},
+		{false, WC_ADJECTIVE, MOD_DET_SILENT},
+		{false, WC_ADVERB, MOD_DEF},
+		{false, WC_VERB, MOD_MANY},
+		{true, WC_PRONOUN, MOD_PLURAL | MOD_OBJECTIVE | MOD_CASE_TITLE},
+		{true, WC_PREPOSITION, MOD_CASE_UPPER},
+		{true, WC_INTERJECTION, MOD_CASE_SENTENCE},
+		{false, WC_PRONOUN, MOD_POSSESSIVE},
+		{false, WC_PRONOUN, MOD_DEF},
+		{false, WC_NOUN, MOD_OBJECTIVE},
+		{false, WC_CONJUNCTION, MOD_PLURAL},
+		{false, WC_PREPOSITION, MOD_INDEF},
+		{true, WC_GIVEN_NAME, MOD_POSSESSIVE | MOD_CASE_UPPER},
+		{true, WC_SURNAME, MOD_PLURAL | MOD_POSSESSIVE},
+		{true, WC_PLACE, MOD_POSSESSIVE},
+		{false, WC_GIVEN_NAME, MOD_PLURAL},
+		{false, WC_SURNAME, MOD_DEF},
+		{false, WC_PLACE, MOD_COMPARATIVE},
 	}
 
 	for _, c := range cases {
@@ -67,3 +109,41 @@ func TestWordClass_CompatibleWith(t *testing.T) {
 		}
 	}
 }
+
+// Tests whether WordClass and FormType are encoded as names of their
+// constants and whether both names and numeric values can be decoded.
+func TestWordClass_Marshal(t *testing.T) {
+	type record struct {
+		WC WordClass `json:"wc"`
+		FT FormType  `json:"ft"`
+	}
+
+	data, err := json.Marshal(record{WC_VERB, FT_NON_COMPARABLE})
+	if err != nil || string(data) != `{"wc":"WC_VERB","ft":"FT_NON_COMPARABLE"}` {
+		t.Errorf("Failed: unexpected JSON: %s, error: %v", data, err)
+	}
+
+	for _, input := range []string{`{"wc":"WC_VERB","ft":"FT_NON_COMPARABLE"}`, `{"wc":3,"ft":4}`} {
+		var r record
+		if err := json.Unmarshal([]byte(input), &r); err != nil || r.WC != WC_VERB || r.FT != FT_NON_COMPARABLE {
+			t.Errorf("Failed for %s: got %v, error: %v", input, r, err)
+		}
+	}
+
+	for _, input := range []string{`{"wc":"WC_UNKNOWN"}`, `{"ft":"FT_UNKNOWN"}`, `{"wc":"wc_verb"}`, `{"wc":11}`, `{"wc":256}`, `{"ft":200}`, `{"ft":-1}`} {
+		var r record
+		if err := json.Unmarshal([]byte(input), &r); err == nil {
+			t.Errorf("Failed for %s: error not returned", input)
+		}
+	}
+
+	for _, v := range []any{WordClass(255), FormType(255)} {
+		if _, err := json.Marshal(v); err == nil {
+			t.Errorf("Failed for %v: undefined value was marshaled", v)
+		}
+	}
+
+	if s := WordClass(255).String(); s != "WordClass(255)" {
+		t.Errorf("Failed: unexpected String for an undefined WordClass: %s", s)
+	}
+}
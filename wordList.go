@@ -0,0 +1,125 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/fs"
+	"iter"
+	"strings"
+)
+
+// wordLists is an immutable snapshot of the Generator's word lists,
+// indexed by WordClass. Every list is sorted A-Z by Word.word field.
+type wordLists [wc_undefined][]Word
+
+// listed returns an iterator over the WordClass-list pairs of lists.
+// WC_PLACE is omitted, because place names have no word list.
+func (lists *wordLists) listed() iter.Seq2[WordClass, []Word] {
+	return func(yield func(WordClass, []Word) bool) {
+		for wc, list := range lists {
+			if WordClass(wc) != WC_PLACE && !yield(WordClass(wc), list) {
+				return
+			}
+		}
+	}
+}
+
+// ReadWordList reads a word list from r and parses it into a slice of Word.
+// Every line must follow the format described in NewGenerator. Lines
+// are streamed one at a time, so the whole file is never held in memory.
+//
+// The following deviations from the strict format are tolerated:
+//   - CRLF line endings
+//   - trailing newline at the end of the list
+//   - blank lines, which are skipped
+//   - comments - lines beginning with '#', which are skipped
+//
+// Returns an error containing the line number if a malformed line
+// is encountered. Errors from r are relayed.
+func ReadWordList(r io.Reader) ([]Word, error) {
+	var words []Word
+
+	err := scanLines(r, func(line string) error {
+		if line[0] == '#' {
+			return nil
+		}
+
+		w, err := NewWord(line)
+		if err != nil {
+			return err
+		}
+
+		words = append(words, w)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return words, nil
+}
+
+// readWordFile opens the file at path in fsys and reads the word list
+// it contains. Errors are prefixed with path.
+func readWordFile(fsys fs.FS, path string) ([]Word, error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	words, err := ReadWordList(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return words, nil
+}
+
+// scanLines reads r line by line, strips CRLF line endings, skips blank
+// lines and calls fn for every remaining line. Errors returned by fn
+// and by r are prefixed with the line number.
+func scanLines(r io.Reader, fn func(line string) error) error {
+	var (
+		n       int
+		scanner = bufio.NewScanner(r)
+	)
+
+	for scanner.Scan() {
+		n++
+
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if len(strings.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		if err := fn(line); err != nil {
+			return fmt.Errorf("line %d '%s': %w", n, line, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("line %d: %w", n+1, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// neng -- Non-Extravagant Name Generator
+// Copyright (C) 2024  Wojciech Głąb (github.com/Zedran)
+//
+// This file is part of neng.
+//
+// neng is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, version 3 only.
+//
+// neng is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with neng.  If not, see <https://www.gnu.org/licenses/>.
+
+package neng
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Zedran/neng/symbols"
+)
+
+// Tests whether ReadWordList tolerates CRLF line endings, comments, blank
+// lines and trailing newlines, and whether it reports malformed lines.
+func TestReadWordList(t *testing.T) {
+	type testCase struct {
+		good     bool
+		input    string
+		expected []string
+	}
+
+	cases := []testCase{
+		{true, "0big\n3small\n", []string{"big", "small"}},                             // Trailing newline
+		{true, "0big\n3small", []string{"big", "small"}},                               // No trailing newline
+		{true, "0big\r\n3small\r\n", []string{"big", "small"}},                         // CRLF
+		{true, "# adjectives\n0big\n\n  \n#3tiny\n3small\n", []string{"big", "small"}}, // Comments and blank lines
+		{true, "1good,better,best\r\n", []string{"good"}},                              // Irregular forms, CRLF
+		{true, "", nil},                        // Empty input
+		{false, "0big\n1small\n", nil},         // Error: irregular without irregular forms
+		{false, "0big\r\n\r\n9small\r\n", nil}, // Error: undefined FormType
+		{false, "big\n", nil},                  // Error: no FormType
+	}
+
+	for i, c := range cases {
+		out, err := ReadWordList(strings.NewReader(c.input))
+
+		if !c.good {
+			if err == nil {
+				t.Errorf("Failed for case %d: no error returned, got %v", i, out)
+			} else if !errors.Is(err, symbols.ErrBadWordList) {
+				t.Errorf("Failed for case %d: ErrBadWordList not wrapped: %v", i, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Failed for case %d: error returned: %v", i, err)
+			continue
+		}
+
+		if len(out) != len(c.expected) {
+			t.Errorf("Failed for case %d: expected %d words, got %d", i, len(c.expected), len(out))
+			continue
+		}
+
+		for j, w := range out {
+			if w.word != c.expected[j] {
+				t.Errorf("Failed for case %d: expected '%s', got '%s'", i, c.expected[j], w.word)
+			}
+		}
+	}
+
+	if _, err := ReadWordList(strings.NewReader("0big\r\n\r\n9small\r\n")); !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("Failed: line number not reported correctly: %v", err)
+	}
+}
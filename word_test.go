@@ -18,6 +18,7 @@
 package neng
 
 import (
+	"encoding/json"
 	"slices"
 	"testing"
 )
@@ -175,3 +176,45 @@ func TestWord_Irr(t *testing.T) {
 		}
 	}
 }
+
+// Tests whether Word.String, Word.MarshalText and Word.MarshalJSON produce
+// the output that can be decoded back into an identical Word.
+func TestWord_Marshal(t *testing.T) {
+	lines := []string{"0word", "1word,f2", "1good,better,best", "1word,f1a b,f2", "2odds", "3big", "4own", "5mud"}
+
+	for _, ln := range lines {
+		w, err := NewWord(ln)
+		if err != nil {
+			t.Fatalf("Failed: NewWord returned an error for '%s': %v", ln, err)
+		}
+
+		if w.String() != ln {
+			t.Errorf("Failed for '%s': String returned '%s'", ln, w.String())
+		}
+
+		text, _ := w.MarshalText()
+
+		var fromText Word
+		if err := fromText.UnmarshalText(text); err != nil || fromText.word != w.word || !sameForms(fromText, w) {
+			t.Errorf("Failed for '%s': text round trip returned %v, error: %v", ln, fromText, err)
+		}
+
+		data, err := json.Marshal(w)
+		if err != nil {
+			t.Errorf("Failed for '%s': MarshalJSON returned an error: %v", ln, err)
+			continue
+		}
+
+		var fromJSON Word
+		if err := json.Unmarshal(data, &fromJSON); err != nil || fromJSON.word != w.word || !sameForms(fromJSON, w) {
+			t.Errorf("Failed for '%s': JSON round trip returned %v, error: %v", ln, fromJSON, err)
+		}
+	}
+
+	var w Word
+	for _, bad := range []string{`{"word":"","form_type":"FT_REGULAR"}`, `{"word":"a","form_type":"FT_IRREGULAR"}`, `{"word":"a","form_type":"FT_X"}`, `[]`} {
+		if err := json.Unmarshal([]byte(bad), &w); err == nil {
+			t.Errorf("Failed for '%s': error not returned", bad)
+		}
+	}
+}